@@ -0,0 +1,170 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"reflect"
+
+	"github.com/google/uuid"
+)
+
+// ArrayContains sql: k @> ?, the array column contains all values (PostgreSQL)
+// The values (or one Go slice) are bound as one typed array arg, skipped if no value left
+// Qdrant: match.value of each value (all must match)
+// Other SQL Customs and the default SQL return DialectError, see arrayError()
+//
+// Example:
+//
+//	xb.Of("t_post").Custom(xb.DefaultPostgresCustom()).ArrayContains("tags", []string{"go", "sql"}).Build()
+//	// SELECT * FROM t_post WHERE tags @> $1   args: [[]string{"go", "sql"}]
+func (cb *CondBuilder) ArrayContains(k string, vs ...interface{}) *CondBuilder {
+	return cb.doArray(ARRAY_CONTAINS, k, vs)
+}
+
+// ArrayOverlaps sql: k && ?, the array column has any of the values (PostgreSQL)
+// Qdrant: match.any
+func (cb *CondBuilder) ArrayOverlaps(k string, vs ...interface{}) *CondBuilder {
+	return cb.doArray(ARRAY_OVERLAPS, k, vs)
+}
+
+// ArrayContainedBy sql: k <@ ?, all elements of the array column are in the values (PostgreSQL)
+// Ignored by Qdrant (no equivalent filter)
+func (cb *CondBuilder) ArrayContainedBy(k string, vs ...interface{}) *CondBuilder {
+	return cb.doArray(ARRAY_CONTAINED_BY, k, vs)
+}
+
+// AnyEq sql: ? = ANY(k), the array column has the value (PostgreSQL), nil/0/"" are ignored as Eq()
+// Qdrant: match.value (matches any element of array payloads)
+//
+// Example:
+//
+//	xb.Of("t_user").Custom(xb.DefaultPostgresCustom()).AnyEq("roles", "admin").Build()
+//	// SELECT * FROM t_user WHERE $1 = ANY(roles)
+func (cb *CondBuilder) AnyEq(k string, v interface{}) *CondBuilder {
+	v, ok := cb.filterValue(v)
+	if !ok {
+		return cb
+	}
+	return cb.addBb(ANY_EQ, k, v)
+}
+
+func (cb *CondBuilder) doArray(op string, k string, vs []interface{}) *CondBuilder {
+	arr := arrayElems(expandSlice(vs))
+	if len(arr) == 0 {
+		return cb
+	}
+	return cb.addBb(op, k, toTypedSlice(arr))
+}
+
+// arrayElems the values of the array conditions as the predeclared types, the same as Filter() in:
+// named types (type Tag string) are converted, uuid.UUID as string, nil / zero numbers are skipped
+func arrayElems(vs []interface{}) []interface{} {
+	arr := make([]interface{}, 0, len(vs))
+	for _, v := range vs {
+		if v == nil {
+			continue
+		}
+		if !isFilterInElem(reflect.TypeOf(v)) {
+			arr = append(arr, inValues([]interface{}{v})...)
+			continue
+		}
+		if ev, ok := filterInValue(reflect.ValueOf(v)); ok {
+			arr = append(arr, ev)
+		}
+	}
+	return arr
+}
+
+// expandSlice the elements of a single Go slice arg, e.g. ArrayContains("tags", tags)
+func expandSlice(vs []interface{}) []interface{} {
+	if len(vs) != 1 || vs[0] == nil {
+		return vs
+	}
+	if _, ok := vs[0].([]byte); ok {
+		return vs
+	}
+	rv := reflect.ValueOf(vs[0])
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return vs
+	}
+	arr := make([]interface{}, rv.Len())
+	for i := range arr {
+		arr[i] = rv.Index(i).Interface()
+	}
+	return arr
+}
+
+// arrayValue Go slice of InsertBuilder / UpdateBuilder.Set() for array columns,
+// bound as one typed array arg (uuid.UUID as string), false if the slice is nil
+func arrayValue(v interface{}) (interface{}, bool) {
+	switch arr := v.(type) {
+	case []uuid.UUID:
+		if arr == nil {
+			return nil, false
+		}
+		ss := make([]string, len(arr))
+		for i, u := range arr {
+			ss[i] = u.String()
+		}
+		return ss, true
+	case []interface{}:
+		if arr == nil {
+			return nil, false
+		}
+		return toTypedSlice(arr), true
+	}
+	if reflect.ValueOf(v).IsNil() {
+		return nil, false
+	}
+	return v, true
+}
+
+// arrayError DialectError of the dialects without array operators (all but PostgreSQL)
+func (built *Built) arrayError(dialect string) error {
+	for _, bbs := range [][]Bb{built.Conds, built.Havings} {
+		if findBb(bbs, isArrayBb) != nil {
+			return &DialectError{Dialect: dialect, Reason: "array conditions (@>, &&, <@, ANY) are not supported"}
+		}
+	}
+	return nil
+}
+
+func isArrayBb(bb Bb) bool {
+	switch bb.Op {
+	case ARRAY_CONTAINS, ARRAY_OVERLAPS, ARRAY_CONTAINED_BY, ANY_EQ:
+		return true
+	}
+	return false
+}
+
+func (x *BuilderX) ArrayContains(k string, vs ...interface{}) *BuilderX {
+	x.CondBuilder.ArrayContains(k, vs...)
+	return x
+}
+func (x *BuilderX) ArrayOverlaps(k string, vs ...interface{}) *BuilderX {
+	x.CondBuilder.ArrayOverlaps(k, vs...)
+	return x
+}
+func (x *BuilderX) ArrayContainedBy(k string, vs ...interface{}) *BuilderX {
+	x.CondBuilder.ArrayContainedBy(k, vs...)
+	return x
+}
+func (x *BuilderX) AnyEq(k string, v interface{}) *BuilderX {
+	x.CondBuilder.AnyEq(k, v)
+	return x
+}
@@ -0,0 +1,180 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestArray_Operators(t *testing.T) {
+	tags := []string{"go", "sql"}
+	sql, args, _ := Of("t_post").
+		Custom(NewPostgresBuilder().Build()).
+		ArrayContains("tags", tags).
+		ArrayOverlaps("perms", 1, 2).
+		ArrayContainedBy("langs", "en", "zh").
+		AnyEq("roles", "admin").
+		AnyEq("flags", 0).
+		Build().
+		SqlOfSelect()
+
+	want := "SELECT * FROM t_post WHERE tags @> $1 AND perms && $2 AND langs <@ $3 AND $4 = ANY(roles)"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+	wantArgs := []interface{}{[]string{"go", "sql"}, []int{1, 2}, []string{"en", "zh"}, "admin"}
+	if !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("args: %#v\nwant: %#v", args, wantArgs)
+	}
+}
+
+func TestArray_SkipsEmpty(t *testing.T) {
+	sql, _, _ := Of("t_post").
+		ArrayContains("tags", []string{}).
+		ArrayOverlaps("perms").
+		AnyEq("roles", "").
+		Build().
+		SqlOfSelect()
+	if sql != "SELECT * FROM t_post" {
+		t.Errorf("unexpected: %s", sql)
+	}
+}
+
+type postTag string
+
+func TestArray_UUIDAndNamedSlices(t *testing.T) {
+	id := uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	sql, args, _ := Of("t_post").
+		Custom(NewPostgresBuilder().Build()).
+		ArrayContains("ids", []uuid.UUID{id, uuid.Nil}).
+		ArrayOverlaps("tags", []postTag{"go", "sql"}).
+		Build().
+		SqlOfSelect()
+
+	if sql != "SELECT * FROM t_post WHERE ids @> $1 AND tags && $2" {
+		t.Errorf("unexpected: %s", sql)
+	}
+	wantArgs := []interface{}{[]string{id.String()}, []string{"go", "sql"}}
+	if !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("args: %#v\nwant: %#v", args, wantArgs)
+	}
+}
+
+func TestArray_SetSlices(t *testing.T) {
+	id := uuid.New()
+	var nilTags []string
+	sql, args := Of("t_post").
+		Insert(func(ib *InsertBuilder) {
+			ib.Set("title", "xb").
+				Set("tags", []string{"go"}).
+				Set("owners", []uuid.UUID{id}).
+				Set("scores", []interface{}{1, 2}).
+				Set("labels", nilTags)
+		}).
+		Build().
+		SqlOfInsert()
+	if sql != "INSERT INTO t_post (title, tags, owners, scores) VALUES ( ?,  ?,  ?,  ?)" {
+		t.Errorf("unexpected: %s", sql)
+	}
+	wantArgs := []interface{}{"xb", []string{"go"}, []string{id.String()}, []int{1, 2}}
+	if !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("args: %#v", args)
+	}
+
+	_, args = Of("t_post").
+		Update(func(ub *UpdateBuilder) {
+			ub.Set("tags", []string{}).Set("labels", nilTags)
+		}).
+		Eq("id", 1).
+		Build().
+		SqlOfUpdate()
+	if !reflect.DeepEqual(args, []interface{}{[]string{}, 1}) {
+		t.Errorf("empty slice should clear the array, nil slice ignored: %#v", args)
+	}
+}
+
+func TestArray_Rejected(t *testing.T) {
+	cases := []struct {
+		name   string
+		custom Custom
+	}{
+		{"MySQL", NewMySQLBuilder().Build()},
+		{"SQLite", NewSQLiteBuilder().Build()},
+		{"SQLServer", NewSQLServerBuilder().Build()},
+		{"Oracle", NewOracleBuilder().Build()},
+		{"ClickHouse", NewClickHouseBuilder().Build()},
+	}
+	for _, c := range cases {
+		built := Of("t_post").Custom(c.custom).
+			Eq("status", 1).
+			Or(func(cb *CondBuilder) { cb.AnyEq("roles", "admin") }).
+			Build()
+		_, err := c.custom.Generate(built)
+		if _, ok := err.(*DialectError); !ok {
+			t.Errorf("%s: want DialectError, got %v", c.name, err)
+		}
+	}
+
+	built := Of("t_post").ArrayContains("tags", "go").Build()
+	if _, ok := built.CheckDialect().(*DialectError); !ok {
+		t.Errorf("default SQL: want DialectError, got %v", built.CheckDialect())
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("default SQL with array conditions should panic")
+		}
+	}()
+	built.SqlOfSelect()
+}
+
+func TestArray_QdrantMatch(t *testing.T) {
+	built := Of(&CodeVector{}).
+		Custom(NewQdrantBuilder().Build()).
+		VectorSearch("embedding", Vector{0.1, 0.2, 0.3}, 10).
+		ArrayOverlaps("tags", []string{"go", "rust"}).
+		ArrayContains("langs", "en", "zh").
+		AnyEq("roles", "admin").
+		ArrayContainedBy("perms", 1, 2).
+		Build()
+
+	jsonStr, err := built.JsonOfSelect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var req QdrantSearchRequest
+	if err := json.Unmarshal([]byte(jsonStr), &req); err != nil {
+		t.Fatal(err)
+	}
+	must := req.Filter.Must
+	if len(must) != 3 {
+		t.Fatalf("unexpected must: %s", jsonStr)
+	}
+	if must[0].Key != "tags" || len(must[0].Match.Any) != 2 {
+		t.Errorf("ArrayOverlaps() should be match.any: %s", jsonStr)
+	}
+	if len(must[1].Must) != 2 || must[1].Must[1].Match.Value != "zh" {
+		t.Errorf("ArrayContains() should be match.value of each: %s", jsonStr)
+	}
+	if must[2].Key != "roles" || must[2].Match.Value != "admin" {
+		t.Errorf("AnyEq() should be match.value: %s", jsonStr)
+	}
+}
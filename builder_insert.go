@@ -18,6 +18,7 @@ package xb
 
 import (
 	"encoding/json"
+	"reflect"
 	"time"
 	
 	"github.com/google/uuid"
@@ -106,6 +107,13 @@ func (b *InsertBuilder) Set(k string, v interface{}) *InsertBuilder {
 	case []float32, []float64:
 		// ⭐ Vector array: keep as is (for Qdrant/Milvus)
 		// No JSON serialization
+	case []string, []int, []int64, []int32, []int16, []bool, []uuid.UUID, []interface{}:
+		// ⭐ Array column (PostgreSQL text[] / int[] ...): bound as one typed array arg, nil slice is ignored
+		arr, ok := arrayValue(v)
+		if !ok {
+			return b
+		}
+		v = arr
 	// 不添加 case interface{}：实现 driver.Valuer 的结构体（如 BubbleKeywords）应原样传递，
 	// 由 database/sql 调用 Value()；若落入 interface{} 会被 json.Marshal 错误处理
 	}
@@ -116,3 +124,83 @@ func (b *InsertBuilder) Set(k string, v interface{}) *InsertBuilder {
 	})
 	return b
 }
+
+// Struct sets the `db` tagged fields of po, with the same nil/zero filtering as Set()
+//
+// Tag options:
+//   - db:"-": ignored
+//   - db:"name,omitempty": ignored if zero value (e.g. time.Time{})
+//   - db:"id,pk": primary key, ignored if zero (auto increment)
+//
+// Example:
+//
+//	xb.Of(&cat).Insert(func(ib *xb.InsertBuilder) {
+//	    ib.Struct(&cat)
+//	}).Build()
+func (b *InsertBuilder) Struct(po interface{}, opts ...StructOption) *InsertBuilder {
+	eachStructField(po, opts, false, func(sf structField, v interface{}) {
+		if sf.Pk && reflect.ValueOf(v).IsZero() {
+			return
+		}
+		b.Set(sf.Column, v)
+	})
+	return b
+}
+
+// DefaultInsertMaxParams default max parameters of one batch INSERT statement (PostgreSQL / MySQL: 65535)
+const DefaultInsertMaxParams = 65535
+
+// Limits of one batch INSERT statement of SQLServerCustom / SQLiteCustom, used instead of DefaultInsertMaxParams
+const (
+	SQLServerInsertMaxParams = 2100 // parameters of one request
+	SQLServerInsertMaxRows   = 1000 // row value expressions of INSERT ... VALUES
+	SQLiteInsertMaxParams    = 999  // SQLITE_MAX_VARIABLE_NUMBER before 3.32.0
+)
+
+// RowsBuilder rows of batch INSERT, see BuilderX.InsertRows()
+type RowsBuilder struct {
+	rows      [][]Bb
+	maxParams int
+	fill      string
+}
+
+// Row adds one row, nil/empty values are filtered as Insert(), the missing cells are filled with NULL
+func (rb *RowsBuilder) Row(f func(ib *InsertBuilder)) *RowsBuilder {
+	ib := new(InsertBuilder)
+	f(ib)
+	if len(ib.bbs) > 0 {
+		rb.rows = append(rb.rows, ib.bbs)
+	}
+	return rb
+}
+
+// FillDefault fills the missing cells with DEFAULT instead of NULL (not supported by SQLite / Oracle)
+func (rb *RowsBuilder) FillDefault() *RowsBuilder {
+	rb.fill = "DEFAULT"
+	return rb
+}
+
+// MaxParams sets max parameters of one statement of SqlOfInsertBatch()
+// (default: DefaultInsertMaxParams, SQLServerInsertMaxParams of SQLServerCustom, SQLiteInsertMaxParams of SQLiteCustom)
+func (rb *RowsBuilder) MaxParams(n int) *RowsBuilder {
+	if n < 1 {
+		panic("MaxParams(n), n must be > 0")
+	}
+	rb.maxParams = n
+	return rb
+}
+
+// columns union of the columns of all rows, in order of first appearance
+func (rb *RowsBuilder) columns() []Bb {
+	cols := []Bb{}
+	seen := make(map[string]bool)
+	for _, row := range rb.rows {
+		for _, bb := range row {
+			if !seen[bb.Key] {
+				seen[bb.Key] = true
+				cols = append(cols, Bb{Key: bb.Key})
+			}
+		}
+	}
+	return cols
+}
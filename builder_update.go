@@ -18,13 +18,16 @@ package xb
 
 import (
 	"encoding/json"
+	"reflect"
 	"time"
 	
 	"github.com/google/uuid"
 )
 
 type UpdateBuilder struct {
-	bbs []Bb
+	bbs    []Bb
+	pks    []Bb
+	strict bool // ⭐ BuilderX.Strict(): only nil is ignored
 }
 
 func (ub *UpdateBuilder) Set(k string, v interface{}) *UpdateBuilder {
@@ -33,6 +36,23 @@ func (ub *UpdateBuilder) Set(k string, v interface{}) *UpdateBuilder {
 		return ub
 	}
 
+	// ⭐ k = CASE ... END, rendered by the dialect, skipped if no WHEN left
+	if c, ok := v.(*CaseBuilder); ok {
+		if len(c.whens) == 0 {
+			return ub
+		}
+		ub.bbs = append(ub.bbs, Bb{Key: k, Value: c})
+		return ub
+	}
+
+	if ub.strict {
+		return ub.SetZero(k, v)
+	}
+	return ub.set(k, v)
+}
+
+// set k = ?(v) after the value conversion (JSON, array ...), nil/0/empty values are ignored
+func (ub *UpdateBuilder) set(k string, v interface{}) *UpdateBuilder {
 	buffer, ok := v.([]byte)
 	if ok {
 		ub.bbs = append(ub.bbs, Bb{
@@ -106,6 +126,13 @@ func (ub *UpdateBuilder) Set(k string, v interface{}) *UpdateBuilder {
 	case []float32, []float64:
 		// ⭐ Vector array: keep as is (for Qdrant/Milvus)
 		// No JSON serialization
+	case []string, []int, []int64, []int32, []int16, []bool, []uuid.UUID, []interface{}:
+		// ⭐ Array column (PostgreSQL text[] / int[] ...): bound as one typed array arg, nil slice is ignored
+		arr, ok := arrayValue(v)
+		if !ok {
+			return ub
+		}
+		v = arr
 	// 不添加 case interface{}：实现 driver.Valuer 的结构体应原样传递，由 database/sql 调用 Value()
 	}
 
@@ -147,3 +174,31 @@ func (ub *UpdateBuilder) Bool(preCond BoolFunc, f func(cb *UpdateBuilder)) *Upda
 	f(ub)
 	return ub
 }
+
+// Struct sets the `db` tagged fields of po, with the same nil/zero filtering as Set()
+// The pk field is excluded from SET and used as WHERE pk = ?
+//
+// Tag options:
+//   - db:"-": ignored
+//   - db:"name,omitempty": ignored if zero value (e.g. time.Time{})
+//   - db:"id,pk": primary key, panics if zero (never UPDATE the whole table)
+//
+// Example:
+//
+//	xb.Of(&cat).Update(func(ub *xb.UpdateBuilder) {
+//	    ub.Struct(&cat, xb.OmitColumns("created_at"))
+//	}).Build()
+//	// UPDATE t_cat SET name = ?, age = ? WHERE id = ?
+func (ub *UpdateBuilder) Struct(po interface{}, opts ...StructOption) *UpdateBuilder {
+	eachStructField(po, opts, true, func(sf structField, v interface{}) {
+		if !sf.Pk {
+			ub.Set(sf.Column, v)
+			return
+		}
+		if reflect.ValueOf(v).IsZero() {
+			panic("Struct(po), pk " + sf.Column + " can not be zero")
+		}
+		ub.pks = append(ub.pks, Bb{Op: EQ, Key: sf.Column, Value: v})
+	})
+	return ub
+}
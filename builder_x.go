@@ -32,9 +32,11 @@ type BuilderX struct {
 	pageBuilder *PageBuilder
 
 	inserts               *[]Bb
+	insertRows            *RowsBuilder
 	updates               *[]Bb
 	sorts                 []Sort
 	resultKeys            []string
+	selectCases           []selectCase
 	orFromSql             string
 	sxs                   []*FromX
 	svs                   []interface{}
@@ -45,13 +47,22 @@ type BuilderX struct {
 	isDistinct            bool
 	isWithoutOptimization bool
 
-	alia        string
-	limitValue  int                   // ⭐ LIMIT value (v0.10.1)
-	offsetValue int                   // ⭐ OFFSET value (v0.10.1)
-	meta        *interceptor.Metadata // ⭐ Metadata (v0.9.2)
-	customImpl  Custom                // ⭐ Database-specific config (v0.11.0) (private field)
-	withs       []withClause
-	unions      []unionClause
+	alia           string
+	limitValue     int                   // ⭐ LIMIT value (v0.10.1)
+	offsetValue    int                   // ⭐ OFFSET value (v0.10.1)
+	meta           *interceptor.Metadata // ⭐ Metadata (v0.9.2)
+	customImpl     Custom                // ⭐ Database-specific config (v0.11.0) (private field)
+	withs          []withClause
+	unions         []unionClause
+	windows        []WindowClause
+	rankAlias      string
+	lock           *lockClause
+	indexHints     []string
+	optimizerHints []string
+	commentKeys    []string
+	delete         bool
+	deleteTargets  []string
+	allowFullTable bool
 }
 
 type withClause struct {
@@ -189,8 +200,10 @@ func (x *BuilderX) FromX(f func(fb *FromBuilder)) *BuilderX {
 
 	if len(x.sxs) == 0 {
 		sb := FromX{
-			alia: x.alia,
+			alia:       x.alia,
+			indexHints: x.indexHints,
 		}
+		x.indexHints = nil
 		if x.orFromSql != "" {
 			sb.tableName = x.orFromSql
 		}
@@ -228,6 +241,7 @@ func (x *BuilderX) Select(resultKeys ...string) *BuilderX {
 
 func (x *BuilderX) Having(f func(cb *CondBuilderX)) *BuilderX {
 	var cb = new(CondBuilderX)
+	cb.strict = x.strict
 	f(cb)
 	x.havings = cb.bbs
 	return x
@@ -259,6 +273,26 @@ func (x *BuilderX) Sub(s string, f func(sb *BuilderX)) *BuilderX {
 	return x
 }
 
+func (x *BuilderX) Exists(f func(sb *BuilderX)) *BuilderX {
+	x.CondBuilder.Exists(f)
+	return x
+}
+
+func (x *BuilderX) NotExists(f func(sb *BuilderX)) *BuilderX {
+	x.CondBuilder.NotExists(f)
+	return x
+}
+
+func (x *BuilderX) InSub(k string, f func(sb *BuilderX)) *BuilderX {
+	x.CondBuilder.InSub(k, f)
+	return x
+}
+
+func (x *BuilderX) NotInSub(k string, f func(sb *BuilderX)) *BuilderX {
+	x.CondBuilder.NotInSub(k, f)
+	return x
+}
+
 func (x *BuilderX) Any(f func(x *BuilderX)) *BuilderX {
 	f(x)
 	return x
@@ -288,6 +322,14 @@ func (x *BuilderX) Eq(k string, v interface{}) *BuilderX {
 	x.doGLE(EQ, k, v)
 	return x
 }
+func (x *BuilderX) EqZero(k string, v interface{}) *BuilderX {
+	x.CondBuilder.EqZero(k, v)
+	return x
+}
+func (x *BuilderX) NeZero(k string, v interface{}) *BuilderX {
+	x.CondBuilder.NeZero(k, v)
+	return x
+}
 func (x *BuilderX) Ne(k string, v interface{}) *BuilderX {
 	x.doGLE(NE, k, v)
 	return x
@@ -309,24 +351,27 @@ func (x *BuilderX) Lte(k string, v interface{}) *BuilderX {
 	return x
 }
 func (x *BuilderX) Like(k string, v string) *BuilderX {
-	if v == "" {
-		return x
-	}
-	x.doLike(LIKE, k, "%"+v+"%")
+	x.CondBuilder.Like(k, v)
 	return x
 }
 func (x *BuilderX) NotLike(k string, v string) *BuilderX {
-	if v == "" {
-		return x
-	}
-	x.doLike(NOT_LIKE, k, "%"+v+"%")
+	x.CondBuilder.NotLike(k, v)
+	return x
+}
+func (x *BuilderX) ILike(k string, v string) *BuilderX {
+	x.CondBuilder.ILike(k, v)
 	return x
 }
 func (x *BuilderX) LikeLeft(k string, v string) *BuilderX {
-	if v == "" {
-		return x
-	}
-	x.doLike(LIKE, k, v+"%")
+	x.CondBuilder.LikeLeft(k, v)
+	return x
+}
+func (x *BuilderX) LikeRight(k string, v string) *BuilderX {
+	x.CondBuilder.LikeRight(k, v)
+	return x
+}
+func (x *BuilderX) LikeRaw(k string, pattern string) *BuilderX {
+	x.CondBuilder.LikeRaw(k, pattern)
 	return x
 }
 func (x *BuilderX) In(k string, vs ...interface{}) *BuilderX {
@@ -345,6 +390,18 @@ func (x *BuilderX) Nin(k string, vs ...interface{}) *BuilderX {
 	x.doIn(NIN, k, vs...)
 	return x
 }
+
+// InArray sql: key = ANY(?), see CondBuilder.InArray()
+func (x *BuilderX) InArray(k string, vs ...interface{}) *BuilderX {
+	x.doIn(IN_ANY, k, vs...)
+	return x
+}
+
+// NinArray sql: key <> ALL(?), see CondBuilder.NinArray()
+func (x *BuilderX) NinArray(k string, vs ...interface{}) *BuilderX {
+	x.doIn(NIN_ALL, k, vs...)
+	return x
+}
 func (x *BuilderX) IsNull(key string) *BuilderX {
 	x.null(IS_NULL, key)
 	return x
@@ -354,6 +411,12 @@ func (x *BuilderX) NonNull(key string) *BuilderX {
 	return x
 }
 
+// Filter builds conditions from the `xb` tagged fields of the request DTO, see CondBuilder.Filter()
+func (x *BuilderX) Filter(dto interface{}) *BuilderX {
+	x.CondBuilder.Filter(dto)
+	return x
+}
+
 func (x *BuilderX) X(k string, vs ...interface{}) *BuilderX {
 	x.CondBuilder.X(k, vs...)
 	return x
@@ -404,10 +467,33 @@ func (x *BuilderX) Last(last string) *BuilderX {
 	return x
 }
 
+// Update builds UPDATE
+//
+// Notes:
+//   - With JOIN of FromX(), the first table is updated
+//   - MySQL (default): UPDATE t_order o INNER JOIN t_user u ON ... SET o.x = u.y WHERE ...
+//   - PostgreSQL / SQLite: UPDATE t_order AS o SET x = u.y FROM t_user u WHERE <ON> AND ...
+//   - SQL Server: UPDATE o SET o.x = u.y FROM t_order o INNER JOIN t_user u ON ... WHERE ...
+//
+// Example:
+//
+//	xb.Of("t_order").As("o").
+//	    Update(func(ub *xb.UpdateBuilder) {
+//	        ub.X("o.user_name = u.name").Set("o.synced", true)
+//	    }).
+//	    FromX(func(fb *xb.FromBuilder) {
+//	        fb.JOIN(xb.INNER).Of("t_user").As("u").On("u.id = o.user_id")
+//	    }).
+//	    Eq("u.status", 1).
+//	    Build()
 func (x *BuilderX) Update(f func(ub *UpdateBuilder)) *BuilderX {
 	builder := new(UpdateBuilder)
+	builder.strict = x.strict
 	x.updates = &builder.bbs
 	f(builder)
+	for _, pk := range builder.pks {
+		x.Eq(pk.Key, pk.Value)
+	}
 	return x
 }
 
@@ -418,6 +504,37 @@ func (x *BuilderX) Insert(f func(b *InsertBuilder)) *BuilderX {
 	return x
 }
 
+// InsertRows batch INSERT of many rows: INSERT INTO t (a, b) VALUES (?, ?), (?, NULL)
+//
+// Notes:
+//   - Columns are the union of all rows, the missing cells are filled with NULL (or DEFAULT)
+//   - SqlOfInsert() generates one statement, SqlOfInsertBatch() chunks it by MaxParams
+//   - Qdrant: one upsert with many points
+//
+// Example:
+//
+//	built := xb.Of("users").
+//	    InsertRows(func(rb *xb.RowsBuilder) {
+//	        for _, u := range users {
+//	            rb.Row(func(ib *xb.InsertBuilder) {
+//	                ib.Set("name", u.Name).Set("age", u.Age)
+//	            })
+//	        }
+//	    }).
+//	    Build()
+//
+//	for _, r := range built.SqlOfInsertBatch() {
+//	    db.Exec(r.SQL, r.Args...)
+//	}
+func (x *BuilderX) InsertRows(f func(rb *RowsBuilder)) *BuilderX {
+	rb := new(RowsBuilder)
+	f(rb)
+	cols := rb.columns()
+	x.inserts = &cols
+	x.insertRows = rb
+	return x
+}
+
 func (x *BuilderX) Build() *Built {
 	if x == nil {
 		panic("xb.Builder is nil")
@@ -447,6 +564,13 @@ func (x *BuilderX) Build() *Built {
 			Alia:      x.alia,
 			Withs:     withs,
 			Unions:    unions,
+
+			commentKeys: x.commentKeys,
+		}
+		if x.insertRows != nil {
+			built.InsertRows = x.insertRows.rows
+			built.insertMaxParams = x.insertRows.maxParams
+			built.insertFill = x.insertRows.fill
 		}
 
 		// ⭐ Execute AfterBuild interceptors
@@ -462,24 +586,34 @@ func (x *BuilderX) Build() *Built {
 	x.optimizeFromBuilder()
 
 	built := Built{
-		ResultKeys:  x.resultKeys,
-		Updates:     x.updates,
-		Conds:       x.bbs,
-		Sorts:       x.sorts,
-		Aggs:        x.aggs,
-		Havings:     x.havings,
-		GroupBys:    x.groupBys,
-		Last:        x.last,
-		OrFromSql:   baseFrom,
-		Fxs:         x.sxs,
-		Svs:         x.svs,
-		LimitValue:  x.limitValue,
-		OffsetValue: x.offsetValue,
-		Meta:        x.meta,
-		Custom:      x.customImpl,
-		Alia:        x.alia,
-		Withs:       withs,
-		Unions:      unions,
+		ResultKeys:     x.resultKeys,
+		selectCases:    x.selectCases,
+		rankAlias:      x.rankAlias,
+		lock:           x.lock,
+		indexHints:     x.indexHints,
+		optimizerHints: x.optimizerHints,
+		commentKeys:    x.commentKeys,
+		Delete:         x.delete,
+		deleteTargets:  x.deleteTargets,
+		allowFullTable: x.allowFullTable,
+		Updates:        x.updates,
+		Conds:          x.bbs,
+		Sorts:          x.sorts,
+		Aggs:           x.aggs,
+		Havings:        x.havings,
+		GroupBys:       x.groupBys,
+		Last:           x.last,
+		OrFromSql:      baseFrom,
+		Fxs:            x.sxs,
+		Svs:            x.svs,
+		LimitValue:     x.limitValue,
+		OffsetValue:    x.offsetValue,
+		Meta:           x.meta,
+		Custom:         x.customImpl,
+		Alia:           x.alia,
+		Withs:          withs,
+		Unions:         unions,
+		Windows:        x.windows,
 	}
 
 	if x.pageBuilder != nil {
@@ -519,6 +653,7 @@ func (x *BuilderX) buildWithClauses() []WithClause {
 			continue
 		}
 		subBuilt := clause.builder.Build()
+		subBuilt.nested = true
 		sql, args, _ := subBuilt.SqlOfSelect()
 		result = append(result, WithClause{
 			Name:      clause.name,
@@ -540,6 +675,7 @@ func (x *BuilderX) buildUnionClauses() []UnionClause {
 			continue
 		}
 		subBuilt := clause.builder.Build()
+		subBuilt.nested = true
 		sql, args, _ := subBuilt.SqlOfSelect()
 		result = append(result, UnionClause{
 			Operator: clause.operator,
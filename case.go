@@ -0,0 +1,208 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"strings"
+
+	. "github.com/fndome/xb/internal"
+)
+
+type caseWhen struct {
+	bbs   []Bb
+	then  interface{}
+	thenX string
+	isRaw bool
+}
+
+// selectCase SelectCase() at ResultKeys[index], rendered by the dialect
+type selectCase struct {
+	index int
+	c     *CaseBuilder
+	alias string
+}
+
+// CaseBuilder CASE WHEN ... THEN ? ... ELSE ? END, for SelectCase(), SortCase() and UpdateBuilder.Set()
+// WHEN conditions are built by CondBuilder (nil/0/empty values are ignored, the WHEN is skipped if no condition left),
+// THEN / ELSE values are bound as args
+// The WHEN conditions are rendered with the Built, by its Custom (LIKE ESCAPE, quoted identifiers ...)
+type CaseBuilder struct {
+	whens     []caseWhen
+	elseValue interface{}
+	elseX     string
+	hasElse   bool
+}
+
+// Case starts a CASE expression
+//
+// Example:
+//
+//	label := xb.Case().
+//	    When(func(cb *xb.CondBuilder) { cb.Eq("status", 1) }, "active").
+//	    When(func(cb *xb.CondBuilder) { cb.Eq("status", 2) }, "locked").
+//	    Else("unknown")
+//
+//	xb.Of("t_user").SelectCase(label, "status_label").Build()
+//	// SELECT CASE WHEN status = ? THEN ? WHEN status = ? THEN ? ELSE ? END AS status_label FROM t_user
+//
+//	xb.Of("t_task").Update(func(ub *xb.UpdateBuilder) {
+//	    ub.Set("priority", xb.Case().
+//	        When(func(cb *xb.CondBuilder) { cb.Lt("due_at", now) }, 1).
+//	        ElseX("priority"))
+//	}).Eq("owner", owner).Build()
+//	// UPDATE t_task SET priority = CASE WHEN due_at < ? THEN ? ELSE priority END WHERE owner = ?
+func Case() *CaseBuilder {
+	return new(CaseBuilder)
+}
+
+// When WHEN conditions THEN ?(then)
+func (c *CaseBuilder) When(f func(cb *CondBuilder), then interface{}) *CaseBuilder {
+	if bbs := caseCondsOf(f); len(bbs) > 0 {
+		c.whens = append(c.whens, caseWhen{bbs: bbs, then: then})
+	}
+	return c
+}
+
+// WhenX WHEN conditions THEN expr (raw SQL, e.g. a column)
+func (c *CaseBuilder) WhenX(f func(cb *CondBuilder), expr string) *CaseBuilder {
+	if bbs := caseCondsOf(f); len(bbs) > 0 {
+		c.whens = append(c.whens, caseWhen{bbs: bbs, thenX: expr, isRaw: true})
+	}
+	return c
+}
+
+// Else ELSE ?(v)
+func (c *CaseBuilder) Else(v interface{}) *CaseBuilder {
+	c.elseValue = v
+	c.elseX = ""
+	c.hasElse = true
+	return c
+}
+
+// ElseX ELSE expr (raw SQL), e.g. ElseX("priority") keeps the column as is in UPDATE
+func (c *CaseBuilder) ElseX(expr string) *CaseBuilder {
+	c.elseValue = nil
+	c.elseX = expr
+	c.hasElse = true
+	return c
+}
+
+func caseCondsOf(f func(cb *CondBuilder)) []Bb {
+	sub := subCondBuilder()
+	f(sub)
+	return sub.bbs
+}
+
+// quoted returns a copy of c, the keys of WHEN conditions are quoted
+func (c *CaseBuilder) quoted(q func(string) string) *CaseBuilder {
+	cloned := *c
+	cloned.whens = make([]caseWhen, len(c.whens))
+	for i, when := range c.whens {
+		when.bbs = quoteBbKeys(when.bbs, q)
+		cloned.whens[i] = when
+	}
+	return &cloned
+}
+
+// toSql CASE ... END by the default SQL, the text of ResultKeys / Sort before rendered by the dialect
+func (c *CaseBuilder) toSql() string {
+	expr, _ := (&Built{}).toCaseSql(c)
+	return expr
+}
+
+// toCaseSql CASE ... END and its args, "" if no WHEN left
+func (built *Built) toCaseSql(c *CaseBuilder) (string, []interface{}) {
+	if len(c.whens) == 0 {
+		return "", nil
+	}
+	vs := []interface{}{}
+	sb := strings.Builder{}
+	sb.WriteString("CASE")
+	for _, when := range c.whens {
+		sb.WriteString(" WHEN ")
+		built.toCondSql(when.bbs, &sb, &vs, nil)
+		sb.WriteString(" THEN ")
+		if when.isRaw {
+			sb.WriteString(when.thenX)
+		} else {
+			sb.WriteString(PLACE_HOLDER_MARK)
+			vs = append(vs, when.then)
+		}
+	}
+	if c.hasElse {
+		sb.WriteString(" ELSE ")
+		if c.elseX != "" {
+			sb.WriteString(c.elseX)
+		} else {
+			sb.WriteString(PLACE_HOLDER_MARK)
+			vs = append(vs, c.elseValue)
+		}
+	}
+	sb.WriteString(" END")
+	return sb.String(), vs
+}
+
+// SelectCase selects CASE ... END AS alias, the THEN / ELSE args are bound before the args of FROM / WHERE
+func (x *BuilderX) SelectCase(c *CaseBuilder, alias string) *BuilderX {
+	if alias == "" {
+		panic("SelectCase(c, alias), alias required")
+	}
+	if len(c.whens) == 0 {
+		return x
+	}
+	x.selectCases = append(x.selectCases, selectCase{index: len(x.resultKeys), c: c, alias: alias})
+	x.resultKeys = append(x.resultKeys, c.toSql()+AS+alias)
+	return x
+}
+
+// resultKeysOf ResultKeys with SelectCase() rendered by the dialect, and the args of them
+func (built *Built) resultKeysOf() ([]string, []interface{}) {
+	if len(built.selectCases) == 0 {
+		return built.ResultKeys, nil
+	}
+	keys := append([]string(nil), built.ResultKeys...)
+	var vs []interface{}
+	for _, sc := range built.selectCases {
+		expr, args := built.toCaseSql(sc.c)
+		keys[sc.index] = expr + AS + sc.alias
+		vs = append(vs, args...)
+	}
+	return keys, vs
+}
+
+// SortCase ORDER BY CASE ... END, e.g. custom priority of status
+//
+// Example:
+//
+//	xb.Of("t_ticket").
+//	    SortCase(xb.Case().
+//	        When(func(cb *xb.CondBuilder) { cb.Eq("level", "urgent") }, 0).
+//	        When(func(cb *xb.CondBuilder) { cb.Eq("level", "high") }, 1).
+//	        Else(9), xb.ASC).
+//	    Sort("id", xb.DESC)
+func (x *BuilderX) SortCase(c *CaseBuilder, direction Direction) *BuilderX {
+	if len(c.whens) == 0 {
+		return x
+	}
+	sort := Sort{orderBy: c.toSql(), caseOf: c}
+	if direction != nil {
+		sort.direction = direction()
+	}
+	x.sorts = append(x.sorts, sort)
+	return x
+}
@@ -0,0 +1,195 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCase_SelectAndSortArgsOrder(t *testing.T) {
+	label := Case().
+		When(func(cb *CondBuilder) { cb.Eq("status", 1) }, "active").
+		When(func(cb *CondBuilder) { cb.Eq("status", 0) }, "skipped").
+		When(func(cb *CondBuilder) { cb.Eq("status", 2).Gt("login_count", 3) }, "locked").
+		Else("unknown")
+
+	built := Of("t_user").
+		Select("id").
+		SelectCase(label, "status_label").
+		Gt("age", 18).
+		SortCase(Case().
+			When(func(cb *CondBuilder) { cb.Eq("level", "vip") }, 0).
+			Else(9), ASC).
+		Sort("id", DESC).
+		Build()
+
+	sql, args, km := built.SqlOfSelect()
+	want := "SELECT id, CASE WHEN status = ? THEN ? WHEN status = ? AND login_count > ? THEN ? ELSE ? END AS status_label " +
+		"FROM t_user WHERE age > ? ORDER BY CASE WHEN level = ? THEN ? ELSE ? END ASC, id DESC"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+	wantArgs := []interface{}{1, "active", 2, 3, "locked", "unknown", 18, "vip", 0, 9}
+	if !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("args: %v\nwant: %v", args, wantArgs)
+	}
+	if km["status_label"] != "status_label" {
+		t.Errorf("alias should be in km: %v", km)
+	}
+}
+
+func TestCase_UpdateSet(t *testing.T) {
+	built := Of("t_task").
+		Update(func(ub *UpdateBuilder) {
+			ub.Set("priority", Case().
+				When(func(cb *CondBuilder) { cb.Lt("due_at", "2025-01-01") }, 1).
+				When(func(cb *CondBuilder) { cb.Eq("owner", "") }, 5).
+				ElseX("priority")).
+				Set("name", "todo")
+		}).
+		Eq("project_id", 7).
+		Build()
+
+	sql, args := built.SqlOfUpdate()
+	want := "UPDATE t_task SET priority = CASE WHEN due_at < ? THEN ? ELSE priority END, name = ?  WHERE project_id = ?"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+	if !reflect.DeepEqual(args, []interface{}{"2025-01-01", 1, "todo", 7}) {
+		t.Errorf("args: %v", args)
+	}
+}
+
+func TestCase_NoWhenIsSkipped(t *testing.T) {
+	empty := Case().When(func(cb *CondBuilder) { cb.Eq("status", nil) }, "x").Else("y")
+
+	sql, args, _ := Of("t_user").
+		SelectCase(empty, "label").
+		SortCase(empty, DESC).
+		Build().
+		SqlOfSelect()
+	if sql != "SELECT * FROM t_user" || len(args) != 0 {
+		t.Errorf("unexpected: %s %v", sql, args)
+	}
+
+	sql, args = Of("t_user").
+		Update(func(ub *UpdateBuilder) {
+			ub.Set("label", empty).Set("name", "n")
+		}).
+		Eq("id", 1).
+		Build().
+		SqlOfUpdate()
+	if sql != "UPDATE t_user SET name = ?  WHERE id = ?" || len(args) != 2 {
+		t.Errorf("unexpected: %s %v", sql, args)
+	}
+}
+
+func TestCase_PostgresPlaceholders(t *testing.T) {
+	built := Of("t_user").
+		Custom(DefaultPostgresCustom()).
+		SelectCase(Case().
+			When(func(cb *CondBuilder) { cb.Gte("score", 90) }, "A").
+			Else("B"), "grade").
+		Eq("class", "c1").
+		Build()
+
+	sql, args, _ := built.SqlOfSelect()
+	want := "SELECT CASE WHEN score >= $1 THEN $2 ELSE $3 END AS grade FROM t_user WHERE class = $4"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+	if !reflect.DeepEqual(args, []interface{}{90, "A", "B", "c1"}) {
+		t.Errorf("args: %v", args)
+	}
+}
+
+func TestCase_PagedCountUsesStar(t *testing.T) {
+	built := Of("t_user").
+		SelectCase(Case().
+			When(func(cb *CondBuilder) { cb.Eq("status", 1) }, "active").
+			Else("unknown"), "status_label").
+		Select("id").
+		Gt("age", 18).
+		Paged(func(pb *PageBuilder) {
+			pb.Page(2).Rows(10)
+		}).
+		Build()
+
+	countSql, dataSql, args, _ := built.SqlOfPage()
+	if want := "SELECT COUNT(*) FROM t_user WHERE age > ?"; countSql != want {
+		t.Errorf("got:  %s\nwant: %s", countSql, want)
+	}
+	if want := "SELECT CASE WHEN status = ? THEN ? ELSE ? END AS status_label, id FROM t_user WHERE age > ? LIMIT 10 OFFSET 10"; dataSql != want {
+		t.Errorf("got:  %s\nwant: %s", dataSql, want)
+	}
+	wantArgs := []interface{}{1, "active", "unknown", 18}
+	if !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("args: %v\nwant: %v", args, wantArgs)
+	}
+	if _, countArgs := built.SqlOfCount(); !reflect.DeepEqual(countArgs, []interface{}{18}) {
+		t.Errorf("count args: %v", countArgs)
+	}
+}
+
+func TestCase_SQLiteLikeEscape(t *testing.T) {
+	label := Case().
+		When(func(cb *CondBuilder) { cb.Like("name", "50%_off") }, "promo").
+		Else("plain")
+
+	built := Of("t_product").
+		Custom(DefaultSQLiteCustom()).
+		SelectCase(label, "kind").
+		SortCase(Case().
+			When(func(cb *CondBuilder) { cb.LikeLeft("code", "A_") }, 0).
+			Else(1), ASC).
+		Build()
+
+	sql, args, _ := built.SqlOfSelect()
+	want := `SELECT CASE WHEN name LIKE ? ESCAPE '\' THEN ? ELSE ? END AS kind FROM t_product ` +
+		`ORDER BY CASE WHEN code LIKE ? ESCAPE '\' THEN ? ELSE ? END ASC`
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+	wantArgs := []interface{}{`%50\%\_off%`, "promo", "plain", `A\_%`, 0, 1}
+	if !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("args: %v\nwant: %v", args, wantArgs)
+	}
+}
+
+func TestCase_SQLServerUpdateQuotedLike(t *testing.T) {
+	built := Of("t_task").
+		Custom(DefaultSQLServerCustom()).
+		Update(func(ub *UpdateBuilder) {
+			ub.Set("priority", Case().
+				When(func(cb *CondBuilder) { cb.Like("title", "[urgent]") }, 1).
+				ElseX("priority"))
+		}).
+		Eq("owner", "bob").
+		Build()
+
+	sql, args := built.SqlOfUpdate()
+	want := `UPDATE [t_task] SET [priority] = CASE WHEN [title] LIKE @p1 ESCAPE '\' THEN @p2 ELSE priority END  WHERE [owner] = @p3`
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+	wantArgs := []interface{}{`%\[urgent]%`, 1, "bob"}
+	if !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("args: %v\nwant: %v", args, wantArgs)
+	}
+}
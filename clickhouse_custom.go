@@ -0,0 +1,422 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	. "github.com/fndome/xb/internal"
+)
+
+// ============================================================================
+// ClickHouseBuilder: Builder Pattern Configuration Builder
+// ============================================================================
+
+// ClickHouseBuilder ClickHouse configuration builder
+// Uses Builder pattern to construct ClickHouseCustom configuration
+type ClickHouseBuilder struct {
+	custom *ClickHouseCustom
+}
+
+// NewClickHouseBuilder creates a ClickHouse configuration builder
+//
+// Example:
+//
+//	xb.Of("events").Custom(
+//	    xb.NewClickHouseBuilder().
+//	        Final().
+//	        Prewhere(func(cb *xb.CondBuilder) {
+//	            cb.Eq("event_date", "2025-01-01")
+//	        }).
+//	        LimitBy(3, "user_id").
+//	        Settings("max_threads", 8).
+//	        Build(),
+//	).Build()
+func NewClickHouseBuilder() *ClickHouseBuilder {
+	return &ClickHouseBuilder{
+		custom: newClickHouseCustom(),
+	}
+}
+
+// Final sets FROM t FINAL (merge rows of ReplacingMergeTree / CollapsingMergeTree ... at query time)
+func (cb *ClickHouseBuilder) Final() *ClickHouseBuilder {
+	cb.custom.Final = true
+	return cb
+}
+
+// Sample sets FROM t SAMPLE expr
+//
+// Example:
+//
+//	Sample("0.1")               // SAMPLE 0.1
+//	Sample("1/10 OFFSET 1/2")   // SAMPLE 1/10 OFFSET 1/2
+func (cb *ClickHouseBuilder) Sample(expr string) *ClickHouseBuilder {
+	cb.custom.Sample = expr
+	return cb
+}
+
+// Prewhere adds PREWHERE conditions (read before the other columns, filtered as WHERE)
+// nil/0/empty values are ignored, same as WHERE conditions
+func (cb *ClickHouseBuilder) Prewhere(f func(cb *CondBuilder)) *ClickHouseBuilder {
+	sub := subCondBuilder()
+	f(sub)
+	cb.custom.Prewheres = append(cb.custom.Prewheres, sub.bbs...)
+	return cb
+}
+
+// LimitBy sets LIMIT n BY cols (n rows of each distinct cols)
+func (cb *ClickHouseBuilder) LimitBy(n int, cols ...string) *ClickHouseBuilder {
+	if n < 1 || len(cols) == 0 {
+		panic("LimitBy(n, cols...), n must be > 0 and cols can not be empty")
+	}
+	cb.custom.LimitByRows = n
+	cb.custom.LimitByCols = append([]string(nil), cols...)
+	return cb
+}
+
+// Settings appends SETTINGS name = value of SELECT (called in order)
+func (cb *ClickHouseBuilder) Settings(name string, value interface{}) *ClickHouseBuilder {
+	cb.custom.Settings = append(cb.custom.Settings, ClickHouseSetting{Name: name, Value: value})
+	return cb
+}
+
+// LightweightDelete uses DELETE FROM t WHERE ... instead of ALTER TABLE t DELETE WHERE ...
+func (cb *ClickHouseBuilder) LightweightDelete() *ClickHouseBuilder {
+	cb.custom.LightweightDelete = true
+	return cb
+}
+
+// Build constructs and returns ClickHouseCustom configuration
+func (cb *ClickHouseBuilder) Build() *ClickHouseCustom {
+	return cb.custom
+}
+
+// ============================================================================
+// ClickHouseCustom: ClickHouse-Specific Configuration
+// ============================================================================
+
+// ClickHouseSetting one item of SETTINGS
+type ClickHouseSetting struct {
+	Name  string
+	Value interface{}
+}
+
+// ClickHouseCustom ClickHouse database-specific configuration
+//
+// Notes:
+//   - Update: ALTER TABLE t UPDATE a = ? WHERE ... (mutation)
+//   - Delete: ALTER TABLE t DELETE WHERE ... (mutation), or lightweight DELETE FROM t WHERE ...
+//   - Mutations require WHERE, without conditions Generate() returns ErrFullTable,
+//     WHERE 1 = 1 is generated only with AllowFullTable()
+//   - Select: FROM t FINAL SAMPLE ... PREWHERE ... WHERE ... ORDER BY ... LIMIT n BY ... LIMIT ... SETTINGS ...
+//   - Parameters are ? (clickhouse-go)
+//
+// Example:
+//
+//	built := xb.Of("events").
+//	    Custom(xb.DefaultClickHouseCustom()).
+//	    Update(func(ub *xb.UpdateBuilder) {
+//	        ub.Set("status", "done")
+//	    }).
+//	    Eq("id", 1).
+//	    Build()
+//
+//	sql, args := built.SqlOfUpdate()
+//	// ALTER TABLE events UPDATE status = ? WHERE id = ?
+type ClickHouseCustom struct {
+	// Final FROM t FINAL
+	Final bool
+
+	// Sample FROM t SAMPLE expr
+	Sample string
+
+	// Prewheres PREWHERE conditions
+	Prewheres []Bb
+
+	// LimitByRows / LimitByCols LIMIT n BY cols
+	LimitByRows int
+	LimitByCols []string
+
+	// Settings trailing SETTINGS of SELECT
+	Settings []ClickHouseSetting
+
+	// LightweightDelete DELETE FROM t WHERE ... (default: ALTER TABLE t DELETE WHERE ...)
+	LightweightDelete bool
+}
+
+// newClickHouseCustom internal function: creates default ClickHouse Custom
+func newClickHouseCustom() *ClickHouseCustom {
+	return &ClickHouseCustom{}
+}
+
+// ============================================================================
+// Implements Custom Interface
+// ============================================================================
+
+// Generate implements Custom interface
+//
+// Returns:
+//   - interface{}: *SQLResult
+//   - error
+func (c *ClickHouseCustom) Generate(built *Built) (interface{}, error) {
+	built = built.withDialect(dialectClickHouse).withLikeDialect("", true)
+	if err := built.fullTextError("clickhouse"); err != nil {
+		return nil, err
+	}
+	if err := built.jsonPathError("clickhouse"); err != nil {
+		return nil, err
+	}
+	if err := built.arrayError("clickhouse"); err != nil {
+		return nil, err
+	}
+	if err := built.noLockError("clickhouse"); err != nil {
+		return nil, err
+	}
+	vs := []interface{}{}
+
+	// ⭐ Insert scenario: standard INSERT INTO t (...) VALUES (...)
+	if built.Inserts != nil {
+		sql := built.SqlInsert(&vs)
+		return &SQLResult{SQL: sql, Args: vs}, nil
+	}
+
+	// ⭐ Update scenario: ALTER TABLE t UPDATE ... WHERE ...
+	if built.Updates != nil {
+		if err := built.joinedUpdateError("clickhouse"); err != nil {
+			return nil, err
+		}
+		if err := built.CheckFullTable(); err != nil {
+			return nil, err
+		}
+		sb := strings.Builder{}
+		sb.WriteString("ALTER TABLE ")
+		built.toFromSql(&vs, &sb)
+		setB := strings.Builder{}
+		built.toUpdateSql(&setB, &vs)
+		sb.WriteString(" UPDATE ")
+		sb.WriteString(strings.TrimSpace(strings.TrimPrefix(setB.String(), SET)))
+		c.toMutationWhereSql(built, &sb, &vs)
+		return &SQLResult{SQL: sb.String(), Args: vs}, nil
+	}
+
+	// ⭐ Delete scenario: ALTER TABLE t DELETE WHERE ... / DELETE FROM t WHERE ...
+	if built.Delete {
+		if err := built.joinedDeleteError("clickhouse"); err != nil {
+			return nil, err
+		}
+		if err := built.CheckFullTable(); err != nil {
+			return nil, err
+		}
+		sb := strings.Builder{}
+		if c.LightweightDelete {
+			sb.WriteString(DELETE)
+			sb.WriteString(FROM)
+			built.toFromSql(&vs, &sb)
+		} else {
+			sb.WriteString("ALTER TABLE ")
+			built.toFromSql(&vs, &sb)
+			sb.WriteString(" DELETE")
+		}
+		c.toMutationWhereSql(built, &sb, &vs)
+		return &SQLResult{SQL: sb.String(), Args: vs}, nil
+	}
+
+	// ⭐ Select scenario
+	km := make(map[string]string)
+	sb := strings.Builder{}
+	sb.Grow(256)
+	c.toSelectSql(built, &sb, &vs, km, true)
+	built.toPageSql(&sb)
+	c.toSettingsSql(&sb)
+	built.toLastSql(&sb)
+
+	countSql, countArgs := c.sqlCount(built)
+	return &SQLResult{
+		SQL:       sb.String(),
+		CountSQL:  countSql,
+		Args:      vs,
+		CountArgs: countArgs,
+		Meta:      km,
+	}, nil
+}
+
+// ============================================================================
+// Internal Implementation
+// ============================================================================
+
+// toSelectSql writes SELECT ... LIMIT n BY ... (without pagination)
+// withLast: with the cursor condition of Paged().Last() (not for COUNT)
+func (c *ClickHouseCustom) toSelectSql(built *Built, sb *strings.Builder, vs *[]interface{}, km map[string]string, withLast bool) {
+	var filterLast func() *Bb
+	if withLast {
+		filterLast = built.filterLast
+	}
+	built.appendWithClauses(sb, vs)
+	built.toResultKeySql(sb, vs, km)
+	sb.WriteString(FROM)
+	c.toFromSql(built, vs, sb)
+	c.toPrewhereSql(built, vs, sb)
+	if withLast {
+		built.sqlWhere(sb)
+	} else {
+		built.countSqlWhere(sb)
+	}
+	built.toCondSql(built.Conds, sb, vs, filterLast)
+	built.toAggSql(vs, sb)
+	built.toGroupBySql(sb)
+	built.toHavingSql(vs, sb)
+	built.toWindowSql(sb)
+	built.appendUnionClauses(sb, vs)
+	built.toSortSql(sb, vs)
+	c.toLimitBySql(sb)
+}
+
+// sqlCount COUNT SQL with FINAL / SAMPLE / PREWHERE, and its args
+// With LIMIT n BY (or GROUP BY / DISTINCT / UNION ...), counts the rows of the query: SELECT COUNT(*) FROM (SELECT ... LIMIT n BY ...)
+func (c *ClickHouseCustom) sqlCount(built *Built) (string, []interface{}) {
+	sbCount := built.countBuilder()
+	if sbCount == nil {
+		return "", nil
+	}
+	vs := []interface{}{}
+	if c.LimitByRows > 0 || built.isCountWrapped() {
+		core := built
+		if c.LimitByRows == 0 {
+			core = built.countCore() // ⭐ LIMIT n BY counts the rows of the select list as is
+		}
+		sbCount.WriteString(COUNT_BASE_SCRIPT)
+		sbCount.WriteString(FROM)
+		sbCount.WriteString(BEGIN_SUB)
+		c.toSelectSql(core, sbCount, &vs, make(map[string]string), false)
+		sbCount.WriteString(END_SUB)
+	} else {
+		built.appendWithClauses(sbCount, &vs)
+		built.toResultKeySqlOfCount(sbCount)
+		sbCount.WriteString(FROM)
+		c.toFromSql(built, &vs, sbCount)
+		c.toPrewhereSql(built, &vs, sbCount)
+		built.countSqlWhere(sbCount)
+		built.toCondSql(built.Conds, sbCount, &vs, nil)
+		built.toAggSql(&vs, sbCount)
+		built.toGroupBySqlOfCount(sbCount)
+		built.toHavingSql(&vs, sbCount)
+	}
+	c.toSettingsSql(sbCount)
+	return sbCount.String(), vs
+}
+
+// toFromSql writes FINAL / SAMPLE right after the main table
+func (c *ClickHouseCustom) toFromSql(built *Built, vs *[]interface{}, bp *strings.Builder) {
+	if built.OrFromSql != "" || len(built.Fxs) == 0 {
+		built.toFromSql(vs, bp)
+		c.toFinalSampleSql(bp)
+		return
+	}
+	for i, fx := range built.Fxs {
+		built.toFromSqlByBuilder(vs, fx, bp)
+		if i == 0 {
+			c.toFinalSampleSql(bp)
+		}
+	}
+}
+
+func (c *ClickHouseCustom) toFinalSampleSql(bp *strings.Builder) {
+	if c.Final {
+		bp.WriteString(" FINAL")
+	}
+	if c.Sample != "" {
+		bp.WriteString(" SAMPLE ")
+		bp.WriteString(c.Sample)
+	}
+}
+
+func (c *ClickHouseCustom) toPrewhereSql(built *Built, vs *[]interface{}, bp *strings.Builder) {
+	if len(c.Prewheres) == 0 {
+		return
+	}
+	bp.WriteString(" PREWHERE ")
+	built.toCondSql(c.Prewheres, bp, vs, nil)
+}
+
+func (c *ClickHouseCustom) toLimitBySql(bp *strings.Builder) {
+	if c.LimitByRows < 1 {
+		return
+	}
+	bp.WriteString(LIMIT)
+	bp.WriteString(strconv.Itoa(c.LimitByRows))
+	bp.WriteString(" BY ")
+	bp.WriteString(strings.Join(c.LimitByCols, ", "))
+}
+
+func (c *ClickHouseCustom) toSettingsSql(bp *strings.Builder) {
+	if len(c.Settings) == 0 {
+		return
+	}
+	bp.WriteString(" SETTINGS ")
+	for i, s := range c.Settings {
+		if i > 0 {
+			bp.WriteString(", ")
+		}
+		bp.WriteString(s.Name)
+		bp.WriteString(" = ")
+		switch v := s.Value.(type) {
+		case string:
+			bp.WriteString("'" + strings.ReplaceAll(v, "'", "\\'") + "'")
+		case bool:
+			if v {
+				bp.WriteString("1")
+			} else {
+				bp.WriteString("0")
+			}
+		default:
+			bp.WriteString(fmt.Sprint(v))
+		}
+	}
+}
+
+// toMutationWhereSql ALTER TABLE ... UPDATE / DELETE require WHERE
+// Without conditions (only reached with AllowFullTable()): WHERE 1 = 1
+func (c *ClickHouseCustom) toMutationWhereSql(built *Built, bp *strings.Builder, vs *[]interface{}) {
+	if !built.hasWhere() {
+		bp.WriteString(" WHERE 1 = 1")
+		return
+	}
+	built.sqlWhere(bp)
+	built.toCondSql(built.Conds, bp, vs, built.filterLast)
+}
+
+// ============================================================================
+// Default ClickHouse Custom (Global Singleton)
+// ============================================================================
+
+// defaultClickHouseCustom default ClickHouse Custom instance
+var defaultClickHouseCustom = newClickHouseCustom()
+
+// DefaultClickHouseCustom gets default ClickHouse Custom (singleton)
+//
+// Notes:
+//   - FINAL / SAMPLE / PREWHERE / LIMIT BY / SETTINGS are per query, use NewClickHouseBuilder()
+//
+// Example:
+//
+//	xb.CustomGlobal(xb.DefaultClickHouseCustom())
+func DefaultClickHouseCustom() *ClickHouseCustom {
+	return defaultClickHouseCustom
+}
@@ -0,0 +1,165 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestClickHouseCustom_AlterUpdate(t *testing.T) {
+	built := Of("events").
+		Custom(DefaultClickHouseCustom()).
+		Update(func(ub *UpdateBuilder) {
+			ub.Set("status", "done").Set("retries", 3)
+		}).
+		Eq("id", 1).
+		Build()
+
+	sql, args := built.SqlOfUpdate()
+
+	want := "ALTER TABLE events UPDATE status = ?, retries = ? WHERE id = ?"
+	if sql != want {
+		t.Fatalf("got:  %s\nwant: %s", sql, want)
+	}
+	if len(args) != 3 {
+		t.Errorf("expected 3 args, got %v", args)
+	}
+}
+
+func TestClickHouseCustom_Delete(t *testing.T) {
+	built := Of("events").
+		Custom(DefaultClickHouseCustom()).
+		Lt("event_date", "2024-01-01").
+		Build()
+
+	sql, args := built.SqlOfDelete()
+	if sql != "ALTER TABLE events DELETE WHERE event_date < ?" {
+		t.Errorf("unexpected delete SQL: %s", sql)
+	}
+	if len(args) != 1 {
+		t.Errorf("expected 1 arg, got %v", args)
+	}
+
+	built = Of("events").
+		Custom(NewClickHouseBuilder().LightweightDelete().Build()).
+		Eq("user_id", 7).
+		Build()
+
+	sql, _ = built.SqlOfDelete()
+	if sql != "DELETE FROM events WHERE user_id = ?" {
+		t.Errorf("unexpected lightweight delete SQL: %s", sql)
+	}
+
+	built = Of("events").
+		Custom(DefaultClickHouseCustom()).
+		Build()
+
+	if _, _, err := built.SqlOfDeleteE(); !errors.Is(err, ErrFullTable) {
+		t.Errorf("mutation without conditions should return ErrFullTable, got %v", err)
+	}
+	built.Delete = true
+	if _, err := built.Custom.Generate(built); !errors.Is(err, ErrFullTable) {
+		t.Errorf("Generate() without conditions should return ErrFullTable, got %v", err)
+	}
+
+	built = Of("events").
+		Custom(DefaultClickHouseCustom()).
+		AllowFullTable().
+		Build()
+
+	sql, _, err := built.SqlOfDeleteE()
+	if err != nil || sql != "ALTER TABLE events DELETE WHERE 1 = 1" {
+		t.Errorf("mutation with AllowFullTable() should have WHERE 1 = 1: %s, %v", sql, err)
+	}
+}
+
+func TestClickHouseCustom_SelectClauses(t *testing.T) {
+	custom := NewClickHouseBuilder().
+		Final().
+		Sample("0.1").
+		Prewhere(func(cb *CondBuilder) {
+			cb.Eq("event_date", "2025-01-01").Eq("ignored", "")
+		}).
+		LimitBy(3, "user_id").
+		Settings("max_threads", 8).
+		Settings("join_algorithm", "hash").
+		Build()
+
+	built := Of("events").
+		Custom(custom).
+		Select("user_id", "event").
+		Eq("event", "click").
+		Sort("ts", DESC).
+		Limit(100).
+		Build()
+
+	sql, args, _ := built.SqlOfSelect()
+
+	want := "SELECT user_id, event FROM events FINAL SAMPLE 0.1 PREWHERE event_date = ? WHERE event = ? " +
+		"ORDER BY ts DESC LIMIT 3 BY user_id LIMIT 100 SETTINGS max_threads = 8, join_algorithm = 'hash'"
+	if sql != want {
+		t.Fatalf("got:  %s\nwant: %s", sql, want)
+	}
+	if len(args) != 2 || args[0] != "2025-01-01" || args[1] != "click" {
+		t.Errorf("PREWHERE args should come first, got %v", args)
+	}
+}
+
+func TestClickHouseCustom_FinalWithJoinAndPage(t *testing.T) {
+	built := Of("orders").As("o").
+		Custom(NewClickHouseBuilder().Final().Build()).
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(ASOF).Of("prices").As("p").On("p.sku = o.sku AND p.ts <= o.ts")
+		}).
+		Gt("o.amount", 100).
+		Paged(func(pb *PageBuilder) {
+			pb.Page(2).Rows(10)
+		}).
+		Build()
+
+	countSql, dataSql, _, _ := built.SqlOfPage()
+
+	if !strings.Contains(dataSql, "FROM orders o FINAL ASOF JOIN prices p") {
+		t.Errorf("FINAL should follow the main table: %s", dataSql)
+	}
+	if !strings.HasSuffix(dataSql, "LIMIT 10 OFFSET 10") {
+		t.Errorf("unexpected pagination: %s", dataSql)
+	}
+	if !strings.Contains(countSql, "FROM orders o FINAL ASOF JOIN") {
+		t.Errorf("count SQL should keep FINAL: %s", countSql)
+	}
+}
+
+func TestClickHouseCustom_CountWithLimitBy(t *testing.T) {
+	built := Of("events").
+		Custom(NewClickHouseBuilder().LimitBy(1, "user_id").Build()).
+		Eq("event", "click").
+		Paged(func(pb *PageBuilder) {
+			pb.Page(1).Rows(20)
+		}).
+		Build()
+
+	countSql, _, _, _ := built.SqlOfPage()
+
+	want := "SELECT COUNT(*) FROM (SELECT * FROM events WHERE event = ? LIMIT 1 BY user_id)"
+	if countSql != want {
+		t.Errorf("got:  %s\nwant: %s", countSql, want)
+	}
+}
@@ -16,10 +16,14 @@
 // limitations under the License.
 package xb
 
-import "time"
+import (
+	"reflect"
+	"time"
+)
 
 type CondBuilder struct {
-	bbs []Bb
+	bbs    []Bb
+	strict bool // ⭐ Strict(): only nil is ignored
 }
 
 type BoolFunc func() bool
@@ -38,46 +42,69 @@ func (cb *CondBuilder) doIn(p string, k string, vs ...interface{}) *CondBuilder
 		return cb
 	}
 
-	ss := []string{}
-	length := len(vs)
-	for i := 0; i < length; i++ {
-		v := vs[i]
+	arr := inValues(vs)
+	if len(arr) == 0 {
+		return cb
+	}
+
+	bb := Bb{
+		Op:    p,
+		Key:   k,
+		Value: arr,
+	}
+	cb.bbs = append(cb.bbs, bb)
+
+	return cb
+}
+
+// inValues filters nil/zero values of IN and dereferences number pointers,
+// the typed values are bound as args, never inlined into SQL
+func inValues(vs []interface{}) []interface{} {
+	arr := make([]interface{}, 0, len(vs))
+	for _, v := range vs {
 		if v == nil {
 			continue
 		}
 		switch v.(type) {
 		case string:
-			s := "'"
-			s += v.(string)
-			s += "'"
-			ss = append(ss, s)
+			arr = append(arr, v)
 		case uint64, uint, int, int64, int32, int16, int8, byte, float64, float32:
-			s := N2s(v)
-			if s == "0" {
+			if N2s(v) == "0" {
 				continue
 			}
-			ss = append(ss, s)
+			arr = append(arr, v)
 		case *uint64, *uint, *int, *int64, *int32, *int16, *int8, *byte, *float64, *float32:
-			s, isOK := Np2s(v)
-			if !isOK {
+			isNil, n := NilOrNumber(v)
+			if isNil {
 				continue
 			}
-			ss = append(ss, s)
+			arr = append(arr, n)
 		case interface{}:
 			panic("Builder.doIn(ke, (obj), ([]arr) ? ...")
 		default:
 			panic("Builder.doIn(ke, (*obj)), (*[]arr) ? ...")
 		}
 	}
+	return arr
+}
 
-	bb := Bb{
-		Op:    p,
-		Key:   k,
-		Value: &ss,
+// toTypedSlice converts the values of InArray to a typed slice ([]int64, []string...),
+// so drivers can encode it as one array parameter; mixed types keep []interface{}
+func toTypedSlice(vs []interface{}) interface{} {
+	if len(vs) == 0 {
+		return vs
 	}
-	cb.bbs = append(cb.bbs, bb)
-
-	return cb
+	t := reflect.TypeOf(vs[0])
+	for _, v := range vs[1:] {
+		if reflect.TypeOf(v) != t {
+			return vs
+		}
+	}
+	arr := reflect.MakeSlice(reflect.SliceOf(t), len(vs), len(vs))
+	for i, v := range vs {
+		arr.Index(i).Set(reflect.ValueOf(v))
+	}
+	return arr.Interface()
 }
 
 func (cb *CondBuilder) doLike(p string, k string, v string) *CondBuilder {
@@ -93,6 +120,9 @@ func (cb *CondBuilder) doLike(p string, k string, v string) *CondBuilder {
 }
 
 func (cb *CondBuilder) doGLE(p string, k string, v interface{}) *CondBuilder {
+	if cb.strict {
+		return cb.doGLEStrict(p, k, v)
+	}
 
 	switch v.(type) {
 	case string:
@@ -161,6 +191,16 @@ func (cb *CondBuilder) doGLE(p string, k string, v interface{}) *CondBuilder {
 	return cb.addBb(p, k, v)
 }
 
+// filterValue the value bound by Eq(), false if it's ignored (nil/0/"", or only nil in Strict mode)
+func (cb *CondBuilder) filterValue(v interface{}) (interface{}, bool) {
+	filtered := CondBuilder{strict: cb.strict}
+	filtered.doGLE(EQ, "", v)
+	if len(filtered.bbs) == 0 {
+		return nil, false
+	}
+	return filtered.bbs[0].Value, true
+}
+
 func (cb *CondBuilder) addBb(op string, key string, v interface{}) *CondBuilder {
 	bb := Bb{
 		Op:    op,
@@ -183,6 +223,7 @@ func (cb *CondBuilder) null(op string, k string) *CondBuilder {
 
 func (cb *CondBuilder) orAndSub(orAnd string, f func(cb *CondBuilder)) *CondBuilder {
 	c := subCondBuilder()
+	c.strict = cb.strict
 	f(c)
 	if c.bbs == nil || len(c.bbs) == 0 {
 		return cb
@@ -275,17 +316,27 @@ func (cb *CondBuilder) Lte(k string, v interface{}) *CondBuilder {
 }
 
 // Like sql: LIKE %value%, Like() default has double %
+// % _ \ [ of value are escaped (see LikeRaw() for own wildcards)
 func (cb *CondBuilder) Like(k string, v string) *CondBuilder {
 	if v == "" {
 		return cb
 	}
-	return cb.doLike(LIKE, k, "%"+v+"%")
+	return cb.doLike(LIKE, k, "%"+EscapeLike(v)+"%")
 }
 func (cb *CondBuilder) NotLike(k string, v string) *CondBuilder {
 	if v == "" {
 		return cb
 	}
-	return cb.doLike(NOT_LIKE, k, "%"+v+"%")
+	return cb.doLike(NOT_LIKE, k, "%"+EscapeLike(v)+"%")
+}
+
+// ILike sql: ILIKE %value% (case-insensitive)
+// PostgreSQL / ClickHouse: ILIKE, others: LOWER(k) LIKE LOWER(?)
+func (cb *CondBuilder) ILike(k string, v string) *CondBuilder {
+	if v == "" {
+		return cb
+	}
+	return cb.doLike(ILIKE, k, "%"+EscapeLike(v)+"%")
 }
 
 // LikeLeft sql: LIKE value%, Like() default has double %, then LikeLeft() remove left %
@@ -293,7 +344,23 @@ func (cb *CondBuilder) LikeLeft(k string, v string) *CondBuilder {
 	if v == "" {
 		return cb
 	}
-	return cb.doLike(LIKE, k, v+"%")
+	return cb.doLike(LIKE, k, EscapeLike(v)+"%")
+}
+
+// LikeRight sql: LIKE %value, Like() default has double %, then LikeRight() remove right %
+func (cb *CondBuilder) LikeRight(k string, v string) *CondBuilder {
+	if v == "" {
+		return cb
+	}
+	return cb.doLike(LIKE, k, "%"+EscapeLike(v))
+}
+
+// LikeRaw sql: LIKE pattern, the pattern is bound as is (own % _ wildcards, escaped by \)
+func (cb *CondBuilder) LikeRaw(k string, pattern string) *CondBuilder {
+	if pattern == "" {
+		return cb
+	}
+	return cb.doLike(LIKE, k, pattern)
 }
 func (cb *CondBuilder) In(k string, vs ...interface{}) *CondBuilder {
 	return cb.doIn(IN, k, vs...)
@@ -365,6 +432,22 @@ func (cb *CondBuilder) InRequired(k string, vs ...interface{}) *CondBuilder {
 func (cb *CondBuilder) Nin(k string, vs ...interface{}) *CondBuilder {
 	return cb.doIn(NIN, k, vs...)
 }
+
+// InArray sql: key = ANY(?), the values are bound as one array arg (PostgreSQL)
+// One statement shape serves any list length, so prepared statements can be reused
+//
+// Example:
+//
+//	xb.Of("users").InArray("id", 1, 2, 3).Build()
+//	// SELECT * FROM users WHERE id = ANY(?)   args: [[]int{1, 2, 3}]
+func (cb *CondBuilder) InArray(k string, vs ...interface{}) *CondBuilder {
+	return cb.doIn(IN_ANY, k, vs...)
+}
+
+// NinArray sql: key <> ALL(?), the values are bound as one array arg (PostgreSQL)
+func (cb *CondBuilder) NinArray(k string, vs ...interface{}) *CondBuilder {
+	return cb.doIn(NIN_ALL, k, vs...)
+}
 func (cb *CondBuilder) IsNull(key string) *CondBuilder {
 	return cb.null(IS_NULL, key)
 }
@@ -387,7 +470,8 @@ func (cb *CondBuilder) NonNull(key string) *CondBuilder {
 //     .X("name = ?", name)  // name="" will be filtered
 //     .X("age > ?", age)    // age=0 will be filtered ⚠️
 //
-// ⚠️ Important: If you want to query zero values or false, use the no-parameter approach!
+// ⚠️ Important: If you want to query zero values or false, use the no-parameter approach,
+// or bind them by EqZero() / NeZero() / Strict()
 //
 // Example:
 //
@@ -406,6 +490,9 @@ func (cb *CondBuilder) NonNull(key string) *CondBuilder {
 //	// ✅ Correct: write SQL directly
 //	xb.Of("users").X("age = 0").Build()
 //
+//	// ✅ Correct: bound as arg
+//	xb.Of("users").EqZero("age", 0).Build()
+//
 // ⚠️ For subqueries, use Sub() method (safer and more flexible):
 //
 //	// ❌ Not recommended: handwritten subquery
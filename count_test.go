@@ -0,0 +1,189 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSqlOfCount_ArgsWithoutCursor(t *testing.T) {
+	built := Of("orders").As("o").
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(INNER).Of("users").As("u").On("u.id = o.user_id").
+				Cond(func(on *ON) {
+					on.Eq("u.status", "active")
+				})
+		}).
+		Gt("o.amount", 100).
+		Sort("o.id", DESC).
+		Paged(func(pb *PageBuilder) {
+			pb.Rows(10).Last(500)
+		}).
+		Build()
+
+	_, _, dataArgs, _ := built.SqlOfPage()
+	countSql, countArgs := built.SqlOfCount()
+
+	if len(dataArgs) != 3 {
+		t.Errorf("data SQL should bind the cursor, got %v", dataArgs)
+	}
+	if !reflect.DeepEqual(countArgs, []interface{}{"active", 100}) {
+		t.Errorf("unexpected count args: %v (%s)", countArgs, countSql)
+	}
+}
+
+func TestSqlOfCount_Custom(t *testing.T) {
+	built := Of("users").
+		Custom(DefaultPostgresCustom()).
+		Eq("status", "active").
+		Paged(func(pb *PageBuilder) {
+			pb.Page(2).Rows(10)
+		}).
+		Build()
+
+	countSql, countArgs := built.SqlOfCount()
+	if countSql != "SELECT COUNT(*) FROM users WHERE status = $1" || len(countArgs) != 1 {
+		t.Errorf("unexpected count: %s %v", countSql, countArgs)
+	}
+
+	if sql, _ := Of("users").Build().SqlOfCount(); sql != "" {
+		t.Errorf("no COUNT without Paged(), got %s", sql)
+	}
+}
+
+func TestSqlOfCount_WrapsGroupByAndHaving(t *testing.T) {
+	built := Of("orders").
+		Select("user_id", "SUM(amount) AS total").
+		Gt("amount", 10).
+		GroupBy("user_id").
+		Having(func(cb *CondBuilderX) {
+			cb.Gt("SUM(amount)", 100)
+		}).
+		Sort("user_id", ASC).
+		Paged(func(pb *PageBuilder) {
+			pb.Page(2).Rows(10)
+		}).
+		Build()
+
+	countSql, countArgs := built.SqlOfCount()
+	want := "SELECT COUNT(*) FROM (SELECT user_id FROM orders WHERE amount > ? GROUP BY user_id HAVING SUM(amount) > ?) t"
+	if countSql != want {
+		t.Errorf("got:  %s\nwant: %s", countSql, want)
+	}
+	if !reflect.DeepEqual(countArgs, []interface{}{10, 100}) {
+		t.Errorf("unexpected count args: %v", countArgs)
+	}
+}
+
+func TestSqlOfCount_WrapsDistinctAndUnion(t *testing.T) {
+	built := Of("users").
+		Select("DISTINCT city").
+		Eq("status", 1).
+		Paged(func(pb *PageBuilder) {
+			pb.Rows(10)
+		}).
+		Build()
+	countSql, _ := built.SqlOfCount()
+	if countSql != "SELECT COUNT(*) FROM (SELECT DISTINCT city AS c0 FROM users WHERE status = ?) t" {
+		t.Errorf("unexpected DISTINCT count: %s", countSql)
+	}
+
+	built = Of("users").
+		Select("id").
+		Eq("status", 1).
+		UNION(ALL, func(sb *BuilderX) {
+			sb.From("archived_users").Select("id").Eq("status", 2)
+		}).
+		Sort("id", DESC).
+		Paged(func(pb *PageBuilder) {
+			pb.Rows(10)
+		}).
+		Custom(DefaultPostgresCustom()).
+		Build()
+	countSql, countArgs := built.SqlOfCount()
+	want := "SELECT COUNT(*) FROM (SELECT id FROM users WHERE status = $1 UNION ALL (SELECT id FROM archived_users WHERE status = $2)) t"
+	if countSql != want {
+		t.Errorf("got:  %s\nwant: %s", countSql, want)
+	}
+	if !reflect.DeepEqual(countArgs, []interface{}{1, 2}) {
+		t.Errorf("unexpected count args: %v", countArgs)
+	}
+}
+
+func TestSqlOfCount_ForcedStrategy(t *testing.T) {
+	plain := Of("orders").
+		Gt("amount", 10).
+		GroupBy("user_id").
+		Paged(func(pb *PageBuilder) {
+			pb.Rows(10).CountBy(CountPlain)
+		}).
+		Build()
+	if sql := plain.SqlCount(); sql != "SELECT COUNT(*) FROM orders WHERE amount > ? GROUP BY user_id" {
+		t.Errorf("unexpected plain count: %s", sql)
+	}
+
+	wrapped := Of("orders").
+		Gt("amount", 10).
+		Sort("id", DESC).
+		Paged(func(pb *PageBuilder) {
+			pb.Rows(10).Last(500).CountBy(CountWrapped)
+		}).
+		Build()
+	countSql, countArgs := wrapped.SqlOfCount()
+	if countSql != "SELECT COUNT(*) FROM (SELECT 1 AS c0 FROM orders WHERE amount > ?) t" {
+		t.Errorf("unexpected wrapped count: %s", countSql)
+	}
+	if !reflect.DeepEqual(countArgs, []interface{}{10}) {
+		t.Errorf("unexpected count args: %v", countArgs)
+	}
+}
+
+func TestSqlOfCount_WrappedNamesColumns(t *testing.T) {
+	built := Of("orders").
+		Custom(DefaultSQLServerCustom()).
+		Select("id", "price*qty").
+		Gt("amount", 10).
+		Paged(func(pb *PageBuilder) {
+			pb.Page(1).Rows(10).CountBy(CountWrapped)
+		}).
+		Build()
+	countSql, _ := built.SqlOfCount()
+	if want := "SELECT COUNT(*) FROM (SELECT 1 AS c0 FROM [orders] WHERE [amount] > @p1) t"; countSql != want {
+		t.Errorf("got:  %s\nwant: %s", countSql, want)
+	}
+
+	built = Of("orders").
+		Select("id", "price*qty").
+		Gt("amount", 10).
+		UNION(ALL, func(sb *BuilderX) {
+			sb.From("archived_orders").Select("id", "price*qty")
+		}).
+		Paged(func(pb *PageBuilder) {
+			pb.Rows(10)
+		}).
+		Build()
+	countSql, countArgs := built.SqlOfCount()
+	want := "SELECT COUNT(*) FROM (SELECT id, price*qty AS c1 FROM orders WHERE amount > ? UNION ALL (SELECT id, price*qty FROM archived_orders)) t"
+	if countSql != want {
+		t.Errorf("got:  %s\nwant: %s", countSql, want)
+	}
+	if !reflect.DeepEqual(countArgs, []interface{}{10}) {
+		t.Errorf("unexpected count args: %v", countArgs)
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package xb
+
+import (
+	"errors"
+	"strings"
+
+	. "github.com/fndome/xb/internal"
+)
+
+// ErrFullTable DELETE / UPDATE without WHERE, e.g. all conditions are skipped as nil
+var ErrFullTable = errors.New("xb: DELETE / UPDATE without WHERE, call AllowFullTable() to run it on the full table")
+
+// Delete builds DELETE, for SqlOfDelete() / JsonOfDelete() / xdb.Exec()
+//
+// Notes:
+//   - Without WHERE, SqlOfDelete() panics, SqlOfDeleteE(), CheckFullTable() and xdb.Exec() return ErrFullTable,
+//     see AllowFullTable()
+//   - With JOIN of FromX(): DELETE targets FROM ... JOIN ... (MySQL), DELETE FROM ... USING ... (PostgreSQL)
+//   - targets: the aliases of the tables to delete from (MySQL), the first table of FromX() by default
+//
+// Example:
+//
+//	xb.Of("t_order").As("o").Delete().
+//	    FromX(func(fb *xb.FromBuilder) {
+//	        fb.JOIN(xb.INNER).Of("t_user").As("u").On("u.id = o.user_id")
+//	    }).
+//	    Eq("u.status", 0).
+//	    Build()
+//	// MySQL:      DELETE o FROM t_order o INNER JOIN t_user u ON u.id = o.user_id WHERE u.status = ?
+//	// PostgreSQL: DELETE FROM t_order o USING t_user u WHERE u.id = o.user_id AND u.status = $1
+func (x *BuilderX) Delete(targets ...string) *BuilderX {
+	for _, t := range targets {
+		if strings.TrimSpace(t) == "" {
+			panic("xb.Delete(): empty target")
+		}
+	}
+	x.delete = true
+	x.deleteTargets = targets
+	return x
+}
+
+// AllowFullTable allows DELETE / UPDATE without WHERE
+func (x *BuilderX) AllowFullTable() *BuilderX {
+	x.allowFullTable = true
+	return x
+}
+
+// CheckFullTable ErrFullTable if DELETE / UPDATE has no WHERE without AllowFullTable()
+func (built *Built) CheckFullTable() error {
+	if built.allowFullTable || built.Inserts != nil || (!built.Delete && built.Updates == nil) {
+		return nil
+	}
+	if !built.hasWhere() {
+		return ErrFullTable
+	}
+	return nil
+}
+
+// hasWhere the conditions are not all skipped
+func (built *Built) hasWhere() bool {
+	sb := strings.Builder{}
+	built.toCondSql(built.Conds, &sb, nil, built.filterLast)
+	return strings.TrimSpace(sb.String()) != ""
+}
+
+// SqlOfDeleteE SqlOfDelete() with the guards as errors
+//
+// Notes:
+//   - ErrFullTable without WHERE, unless AllowFullTable()
+//   - *DialectError instead of panic, e.g. DELETE with JOIN on SQLite
+//
+// Example:
+//
+//	sql, args, err := xb.Of("t_order").Delete().Eq("status", status).Build().SqlOfDeleteE()
+//	if errors.Is(err, xb.ErrFullTable) {
+//	    // status is 0, nothing to delete by
+//	}
+func (built *Built) SqlOfDeleteE() (string, []interface{}, error) {
+	built.Delete = true
+	if err := built.CheckFullTable(); err != nil {
+		return "", nil, err
+	}
+	if err := built.CheckDialect(); err != nil {
+		return "", nil, err
+	}
+	sql, args := built.SqlOfDelete()
+	return sql, args, nil
+}
+
+// SqlOfUpdateE SqlOfUpdate() with the guards as errors, see SqlOfDeleteE()
+func (built *Built) SqlOfUpdateE() (string, []interface{}, error) {
+	if err := built.CheckFullTable(); err != nil {
+		return "", nil, err
+	}
+	if err := built.CheckDialect(); err != nil {
+		return "", nil, err
+	}
+	sql, args := built.SqlOfUpdate()
+	return sql, args, nil
+}
+
+func panicIfFullTable(built *Built) {
+	if err := built.CheckFullTable(); err != nil {
+		panic(err.Error())
+	}
+}
+
+// isJoinedDelete DELETE with JOIN of FromX()
+func (built *Built) isJoinedDelete() bool {
+	return built.Delete && built.OrFromSql == "" && len(built.Fxs) > 1
+}
+
+// joinedDeleteError DialectError of DELETE with JOIN (SQLite / Oracle / SQL Server / ClickHouse)
+func (built *Built) joinedDeleteError(dialect string) error {
+	if built.isJoinedDelete() {
+		return &DialectError{Dialect: dialect, Reason: "DELETE with JOIN is not supported"}
+	}
+	return nil
+}
+
+// toDeleteTargetSql writes the targets of DELETE targets FROM ... JOIN ... (MySQL)
+func (built *Built) toDeleteTargetSql(bp *strings.Builder) {
+	if !built.isJoinedDelete() {
+		return
+	}
+	targets := built.deleteTargets
+	if len(targets) == 0 {
+		targets = []string{built.Fxs[0].alia}
+		if targets[0] == "" {
+			targets[0] = built.Fxs[0].tableName
+		}
+	}
+	bp.WriteString(strings.Join(targets, COMMA))
+	bp.WriteString(SPACE)
+}
+
+// sqlDeleteUsing DELETE FROM t USING t2 WHERE <ON of t2> AND ... (PostgreSQL)
+func (built *Built) sqlDeleteUsing(vs *[]interface{}) (string, error) {
+	first := built.Fxs[0]
+	if first.tableName == "" {
+		return "", &DialectError{Dialect: "postgres", Reason: "DELETE from a subquery is not supported"}
+	}
+	for _, t := range built.deleteTargets {
+		if t != first.alia && t != first.tableName {
+			return "", &DialectError{Dialect: "postgres", Reason: "DELETE can only delete from the first table of FromX()"}
+		}
+	}
+
+	sb := strings.Builder{}
+	sb.Grow(128)
+	sb.WriteString(DELETE)
+	sb.WriteString(FROM)
+	sb.WriteString(first.tableName)
+	if first.alia != "" {
+		sb.WriteString(SPACE)
+		sb.WriteString(first.alia)
+	}
+	sb.WriteString(" USING ")
+	ons, err := built.toUsingSql(vs, &sb, "postgres", "DELETE ... USING")
+	if err != nil {
+		return "", err
+	}
+	built.toUsingWhereSql(ons, vs, &sb)
+	return sb.String(), nil
+}
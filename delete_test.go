@@ -0,0 +1,218 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package xb
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDelete_JoinedDefault(t *testing.T) {
+	sql, args := Of("t_order").As("o").Delete().
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(INNER).Of("t_user").As("u").On("u.id = o.user_id")
+		}).
+		Eq("u.status", 9).
+		Lt("o.created_at", "2025-01-01").
+		Build().
+		SqlOfDelete()
+
+	want := "DELETE o FROM t_order o INNER JOIN t_user u ON u.id = o.user_id WHERE u.status = ? AND o.created_at < ?"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+	if len(args) != 2 || args[0] != 9 {
+		t.Errorf("args: %v", args)
+	}
+}
+
+func TestDelete_JoinedMySQL(t *testing.T) {
+	sql, args := Of("t_order").As("o").Custom(NewMySQLBuilder().Build()).Delete().
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(INNER).Of("t_user").As("u").On("u.id = o.user_id")
+		}).
+		Eq("u.status", 9).
+		Build().
+		SqlOfDelete()
+
+	want := "DELETE o FROM t_order o INNER JOIN t_user u ON u.id = o.user_id WHERE u.status = ?"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+	if len(args) != 1 || args[0] != 9 {
+		t.Errorf("args: %v", args)
+	}
+}
+
+func TestDelete_JoinedPostgreSQL(t *testing.T) {
+	sql, args := Of("t_order").As("o").Custom(NewPostgresBuilder().Build()).Delete().
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(INNER).Of("t_user").As("u").On("u.id = o.user_id")
+		}).
+		Eq("u.status", 9).
+		Lt("o.created_at", "2025-01-01").
+		Build().
+		SqlOfDelete()
+
+	want := "DELETE FROM t_order o USING t_user u WHERE u.id = o.user_id AND u.status = $1 AND o.created_at < $2"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+	if len(args) != 2 || args[0] != 9 {
+		t.Errorf("args: %v", args)
+	}
+}
+
+func TestDelete_JoinedWithoutConditions(t *testing.T) {
+	built := Of("t_order").As("o").Custom(NewPostgresBuilder().Build()).Delete().
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(INNER).Of("t_user").As("u").On("u.id = o.user_id")
+		}).
+		Build()
+	if _, _, err := built.SqlOfDeleteE(); !errors.Is(err, ErrFullTable) {
+		t.Errorf("expected ErrFullTable, got %v", err)
+	}
+
+	sql, args, err := Of("t_order").As("o").Custom(NewPostgresBuilder().Build()).Delete().
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(INNER).Of("t_user").As("u").On("u.id = o.user_id")
+		}).
+		AllowFullTable().
+		Build().
+		SqlOfDeleteE()
+	if err != nil || sql != "DELETE FROM t_order o USING t_user u WHERE u.id = o.user_id" || len(args) != 0 {
+		t.Errorf("unexpected: %s %v, %v", sql, args, err)
+	}
+}
+
+func TestDelete_MultiTableTargets(t *testing.T) {
+	sql, args := Of("t_order").As("o").Delete("o", "i").
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(INNER).Of("t_order_item").As("i").On("i.order_id = o.id")
+		}).
+		Eq("o.id", 7).
+		Build().SqlOfDelete()
+	want := "DELETE o, i FROM t_order o INNER JOIN t_order_item i ON i.order_id = o.id WHERE o.id = ?"
+	if sql != want || len(args) != 1 {
+		t.Errorf("\ngot:  %s %v\nwant: %s", sql, args, want)
+	}
+}
+
+func TestDelete_PostgresUsingArgsAndOr(t *testing.T) {
+	sql, args := Of("t_order").As("o").Custom(NewPostgresBuilder().Build()).Delete().
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(INNER).
+				Sub(func(sb *BuilderX) {
+					sb.Select("id").From("t_user").Eq("status", 9)
+				}).As("u").
+				On("u.id = o.user_id")
+		}).
+		Eq("o.kind", 1).OR().Eq("o.kind", 2).
+		Build().SqlOfDelete()
+	want := "DELETE FROM t_order o USING (SELECT id FROM t_user WHERE status = $1) u " +
+		"WHERE u.id = o.user_id AND (o.kind = $2 OR o.kind = $3)"
+	if sql != want {
+		t.Errorf("\ngot:  %s\nwant: %s", sql, want)
+	}
+	if len(args) != 3 || args[0] != 9 || args[2] != 2 {
+		t.Errorf("args: %v", args)
+	}
+
+}
+
+func TestDelete_JoinedDialectErrors(t *testing.T) {
+	leftJoin := func(c Custom) *Built {
+		return Of("t_order").As("o").Custom(c).Delete().
+			FromX(func(fb *FromBuilder) {
+				fb.JOIN(LEFT).Of("t_user").As("u").On("u.id = o.user_id")
+			}).
+			IsNull("u.id").
+			Build()
+	}
+	customs := []Custom{NewPostgresBuilder().Build(), NewSQLiteBuilder().Build(), NewSQLServerBuilder().Build()}
+	for _, c := range customs {
+		built := leftJoin(c)
+		_, err := c.Generate(built)
+		var de *DialectError
+		if !errors.As(err, &de) {
+			t.Errorf("%T: expected DialectError, got %v", c, err)
+		}
+	}
+}
+
+func TestDelete_FullTableGuard(t *testing.T) {
+	var status int // not set, Eq("status", 0) is skipped
+	built := Of("t_order").Delete().Eq("status", status).Build()
+	if err := built.CheckFullTable(); !errors.Is(err, ErrFullTable) {
+		t.Errorf("expected ErrFullTable, got %v", err)
+	}
+	if sql, _, err := built.SqlOfDeleteE(); !errors.Is(err, ErrFullTable) || sql != "" {
+		t.Errorf("expected ErrFullTable without SQL, got %v: %s", err, sql)
+	}
+	func() {
+		defer func() {
+			if r := recover(); r != ErrFullTable.Error() {
+				t.Errorf("SqlOfDelete() should panic with ErrFullTable, got %v", r)
+			}
+		}()
+		built.SqlOfDelete()
+	}()
+
+	update := Of("t_order").Custom(NewPostgresBuilder().Build()).
+		Update(func(ub *UpdateBuilder) { ub.Set("status", 1) }).
+		Eq("status", status).
+		Build()
+	if _, _, err := update.SqlOfUpdateE(); !errors.Is(err, ErrFullTable) {
+		t.Errorf("expected ErrFullTable, got %v", err)
+	}
+	func() {
+		defer func() {
+			if r := recover(); r != ErrFullTable.Error() {
+				t.Errorf("SqlOfUpdate() should panic with ErrFullTable, got %v", r)
+			}
+		}()
+		update.SqlOfUpdate()
+	}()
+
+	sql, _, err := Of("t_order").Delete().AllowFullTable().Build().SqlOfDeleteE()
+	if err != nil || sql != "DELETE FROM t_order" {
+		t.Errorf("got: %s, %v", sql, err)
+	}
+	sql, args, err := Of("t_order").Delete().Eq("status", 2).Build().SqlOfDeleteE()
+	if err != nil || sql != "DELETE FROM t_order WHERE status = ?" || len(args) != 1 {
+		t.Errorf("got: %s %v, %v", sql, args, err)
+	}
+	if err := Of("t_order").Eq("status", status).Build().CheckFullTable(); err != nil {
+		t.Errorf("SELECT is not guarded: %v", err)
+	}
+}
+
+func TestDelete_SqlOfDeleteE_DialectError(t *testing.T) {
+	built := Of("t_order").As("o").Delete().
+		Custom(NewSQLiteBuilder().Build()).
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(INNER).Of("t_user").As("u").On("u.id = o.user_id")
+		}).
+		Eq("u.status", 9).
+		Build()
+
+	_, _, err := built.SqlOfDeleteE()
+	var de *DialectError
+	if !errors.As(err, &de) {
+		t.Errorf("expected DialectError, got %v", err)
+	}
+}
@@ -24,10 +24,11 @@ package xb
 // SQLResult SQL query result (SQL + parameters)
 // Used for SQL databases (PostgreSQL, MySQL, Oracle, etc.)
 type SQLResult struct {
-	SQL      string            // Data SQL (with placeholders)
-	CountSQL string            // Count SQL (optional, for pagination, required by Oracle/ClickHouse, etc.)
-	Args     []interface{}     // Parameter values
-	Meta     map[string]string // Metadata (optional)
+	SQL       string            // Data SQL (with placeholders)
+	CountSQL  string            // Count SQL (optional, for pagination, required by Oracle/ClickHouse, etc.)
+	Args      []interface{}     // Parameter values
+	CountArgs []interface{}     // Count SQL parameter values (optional, default: args of WITH / JOIN ON / WHERE / HAVING)
+	Meta      map[string]string // Metadata (optional)
 }
 
 // ============================================================================
@@ -78,12 +79,12 @@ type SQLResult struct {
 //
 //	json, _ := built.JsonOfSelect()  // ← automatic type conversion
 //
-//	// Oracle (example: future implementation using Builder pattern)
-//	// built := xb.Of("users").
-//	//     Custom(xb.NewOracleBuilder().Build()).
-//	//     Build()
-//	//
-//	// sql, args, _ := built.SqlOfSelect()  // ← automatic type conversion
+//	// Oracle (see oracle_custom.go)
+//	built := xb.Of("users").
+//	    Custom(xb.NewOracleBuilder().Build()).
+//	    Build()
+//
+//	sql, args, _ := built.SqlOfSelect()  // ← automatic type conversion
 type Custom interface {
 	// Generate generates query (unified interface)
 	// Parameters:
@@ -99,6 +100,79 @@ type Custom interface {
 	Generate(built *Built) (interface{}, error)
 }
 
+// DialectError the statement can't be expressed in the dialect of a SQL Custom
+// (e.g. SQL Server OFFSET ... FETCH without ORDER BY)
+//
+// Notes:
+//   - SqlOfSelect() / SqlOfPage() ... fall back to the default SQL for other errors of Custom,
+//     but panic with DialectError, the default SQL would be wrong for the database
+//   - Call Custom.Generate(built) directly to get it as error
+type DialectError struct {
+	Dialect string
+	Reason  string
+}
+
+func (e *DialectError) Error() string {
+	return e.Dialect + ": " + e.Reason
+}
+
+// SQL dialects of Built, set by the Customs ("" for the default SQL, MySQL compatible)
+const (
+	dialectMySQL      = "mysql"
+	dialectPostgres   = "postgres"
+	dialectSQLite     = "sqlite"
+	dialectOracle     = "oracle"
+	dialectSQLServer  = "sqlserver"
+	dialectClickHouse = "clickhouse"
+)
+
+// withDialect returns a copy of built generated by the dialect of Custom
+func (built *Built) withDialect(dialect string) *Built {
+	cloned := *built
+	cloned.dialect = dialect
+	return &cloned
+}
+
+// inheritDialect the subquery renders LIKE / Match() / hints ... as the outer statement
+func (built *Built) inheritDialect(sub *Built) *Built {
+	sub.dialect = built.dialect
+	sub.likeEscape = built.likeEscape
+	sub.nativeILike = built.nativeILike
+	sub.tsConfig = built.tsConfig
+	return sub
+}
+
+// CheckDialect DialectError of the statement, nil if it can be generated
+//
+// Notes:
+//   - SqlOfSelect() / SqlOfPage() / SqlOfUpdate() ... panic with it, executors call CheckDialect() first
+//     to return it as error, e.g. xdb.List() / xdb.Exec()
+//   - With Custom, it's the DialectError of Custom.Generate(), else of Lock() / array conditions in the default SQL
+func (built *Built) CheckDialect() error {
+	if built.Custom == nil {
+		return built.sqlError()
+	}
+	_, err := built.Custom.Generate(built)
+	if de, ok := err.(*DialectError); ok {
+		return de
+	}
+	return nil
+}
+
+// sqlError DialectError of the default SQL (MySQL compatible)
+func (built *Built) sqlError() error {
+	if err := built.lockError("sql"); err != nil {
+		return err
+	}
+	return built.arrayError("sql")
+}
+
+func panicIfDialectError(err error) {
+	if de, ok := err.(*DialectError); ok {
+		panic(de.Error())
+	}
+}
+
 var customGlobal Custom
 
 // CustomGlobal sets the global Custom implementation (only set once, subsequent calls are ignored)
@@ -128,7 +202,7 @@ func CustomGlobal(custom Custom) {
 //  - Oracle pagination: ROWNUM or FETCH FIRST (not LIMIT/OFFSET)
 //  - TimescaleDB: hypertable special syntax
 //
-// Example: ClickHouse Insert
+// Example: ClickHouse Insert (see ClickHouseCustom in clickhouse_custom.go for ALTER TABLE UPDATE / DELETE)
 //
 //	type ClickHouseCustom struct {
 //	    UseJSONFormat bool
@@ -147,7 +221,7 @@ func CustomGlobal(custom Custom) {
 //	    return &SQLResult{SQL: sql, Args: args}, nil
 //	}
 //
-// Example: Oracle pagination (requires CountSQL)
+// Example: Oracle pagination (requires CountSQL, shipped as OracleCustom in oracle_custom.go)
 //
 //	type OracleCustom struct {
 //	    UseRowNum bool
@@ -0,0 +1,310 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// filterField condition of a `xb:"op,column,or:group"` tagged field of filter DTO
+type filterField struct {
+	index  []int
+	op     string
+	column string
+	group  string
+}
+
+// filterFieldsCache reflect.Type → []filterField
+var filterFieldsCache sync.Map
+
+var filterOps = map[string]bool{
+	"eq": true, "ne": true, "gt": true, "gte": true, "lt": true, "lte": true,
+	"like": true, "notlike": true, "ilike": true, "likeleft": true, "likeright": true,
+	"in": true, "nin": true,
+}
+
+// filterCompareOps the ops of Eq/Ne/Gt/Gte/Lt/Lte
+var filterCompareOps = map[string]string{
+	"eq": EQ, "ne": NE, "gt": GT, "gte": GTE, "lt": LT, "lte": LTE,
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// filterFieldsOf gets the xb tagged fields of the DTO type (cached per type)
+func filterFieldsOf(t reflect.Type) []filterField {
+	if cached, ok := filterFieldsCache.Load(t); ok {
+		return cached.([]filterField)
+	}
+	fields := walkFilterFields(t, nil, "")
+	cached, _ := filterFieldsCache.LoadOrStore(t, fields)
+	return cached.([]filterField)
+}
+
+func walkFilterFields(t reflect.Type, parent []int, alias string) []filterField {
+	fields := []filterField{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		index := append(append([]int(nil), parent...), i)
+		tag, tagged := f.Tag.Lookup("xb")
+		if tag == "-" || (!f.IsExported() && !f.Anonymous) {
+			continue
+		}
+
+		// ⭐ Nested struct (or pointer): embedded, or `xb:"alias:u"` prefixes its columns with u.
+		st := f.Type
+		if st.Kind() == reflect.Ptr {
+			st = st.Elem()
+		}
+		if st.Kind() == reflect.Struct && st != timeType && st != uuidType {
+			if !tagged && f.Anonymous {
+				fields = append(fields, walkFilterFields(st, index, alias)...)
+			} else if strings.HasPrefix(tag, "alias:") {
+				fields = append(fields, walkFilterFields(st, index, strings.TrimPrefix(tag, "alias:"))...)
+			}
+			continue
+		}
+		if !tagged {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		if len(parts) < 2 || parts[1] == "" {
+			panic("Filter(dto), tag of " + f.Name + " must be xb:\"op,column\", got xb:\"" + tag + "\"")
+		}
+		ff := filterField{index: index, op: strings.ToLower(strings.TrimSpace(parts[0])), column: strings.TrimSpace(parts[1])}
+		if !filterOps[ff.op] {
+			panic("Filter(dto), unknown op of " + f.Name + ": " + parts[0])
+		}
+		for _, opt := range parts[2:] {
+			opt = strings.TrimSpace(opt)
+			if strings.HasPrefix(opt, "or:") {
+				ff.group = strings.TrimPrefix(opt, "or:")
+			}
+		}
+		if alias != "" && !strings.Contains(ff.column, ".") {
+			ff.column = alias + "." + ff.column
+		}
+
+		ft := f.Type
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		switch ff.op {
+		case "like", "notlike", "ilike", "likeleft", "likeright":
+			if ft.Kind() != reflect.String {
+				panic("Filter(dto), " + ff.op + " of " + f.Name + " requires string or *string")
+			}
+		case "in", "nin":
+			if ft.Kind() != reflect.Slice && ft.Kind() != reflect.Array {
+				panic("Filter(dto), " + ff.op + " of " + f.Name + " requires slice")
+			}
+			if !isFilterInElem(ft.Elem()) {
+				panic("Filter(dto), " + ff.op + " of " + f.Name + " requires slice of string, number, time.Time or uuid.UUID, got " + ft.String())
+			}
+		}
+		fields = append(fields, ff)
+	}
+	return fields
+}
+
+// Filter builds conditions from the `xb` tagged fields of the request DTO,
+// the same as the hand-written chain of Eq/Gte/Like/In..., with the same nil/zero skipping
+//
+// Tag:
+//   - xb:"op,column": op is eq, ne, gt, gte, lt, lte, like, notLike, ilike, likeLeft, likeRight, in, nin
+//   - eq ... lte of a pointer: nil is skipped, a set pointer is bound even if it points to 0 / "" / false
+//   - xb:"op,column,or:group": fields of the same group are joined as AND (a OR b)
+//   - xb:"alias:u" on a nested struct (or pointer, nil is skipped): columns of its fields are prefixed with u.
+//   - in / nin: slice of string, number (named types included), time.Time or uuid.UUID, nil / zero elements are skipped
+//   - xb:"-": ignored
+//
+// Example:
+//
+//	type OrderQuery struct {
+//	    Status  []string   `xb:"in,o.status"`
+//	    Since   *time.Time `xb:"gte,o.created_at"`
+//	    Keyword string     `xb:"like,o.remark,or:kw"`
+//	    Buyer   string     `xb:"like,u.name,or:kw"`
+//	}
+//
+//	xb.Of("orders").As("o").Filter(&req).Build()
+//	// ... WHERE o.status IN (?, ?) AND o.created_at >= ? AND (o.remark LIKE ? OR u.name LIKE ?)
+func (cb *CondBuilder) Filter(dto interface{}) *CondBuilder {
+	rv := structValueOf(dto, "Filter(dto)")
+	fields := filterFieldsOf(rv.Type())
+
+	// zero fields of the DTO are unset, even if Strict()
+	strict := cb.strict
+	cb.strict = false
+	defer func() { cb.strict = strict }()
+
+	done := make(map[string]bool)
+	for _, ff := range fields {
+		if ff.group == "" {
+			cb.filterBy(ff, filterFieldOf(rv, ff.index))
+			continue
+		}
+		if done[ff.group] {
+			continue
+		}
+		done[ff.group] = true
+		group := ff.group
+		cb.And(func(g *CondBuilder) {
+			for _, member := range fields {
+				if member.group != group {
+					continue
+				}
+				sub := subCondBuilder()
+				sub.filterBy(member, filterFieldOf(rv, member.index))
+				if len(sub.bbs) == 0 {
+					continue
+				}
+				if len(g.bbs) > 0 {
+					g.OR()
+				}
+				g.bbs = append(g.bbs, sub.bbs...)
+			}
+		})
+	}
+	return cb
+}
+
+// filterFieldOf the field by index, invalid if a nested struct pointer on the way is nil
+func filterFieldOf(rv reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && rv.Kind() == reflect.Ptr {
+			if rv.IsNil() {
+				return reflect.Value{}
+			}
+			rv = rv.Elem()
+		}
+		rv = rv.Field(x)
+	}
+	return rv
+}
+
+// isFilterInElem element types of in / nin: string, number, time.Time, uuid.UUID (named types and pointers included)
+func isFilterInElem(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == timeType || t == uuidType {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// filterInValue the value of an element of in / nin as the predeclared type (uuid.UUID as string),
+// false if nil or zero number / time / uuid, "" is kept as In()
+func filterInValue(ev reflect.Value) (interface{}, bool) {
+	for ev.Kind() == reflect.Ptr {
+		if ev.IsNil() {
+			return nil, false
+		}
+		ev = ev.Elem()
+	}
+	switch ev.Type() {
+	case timeType:
+		return ev.Interface(), !ev.Interface().(time.Time).IsZero()
+	case uuidType:
+		u := ev.Interface().(uuid.UUID)
+		return u.String(), u != uuid.Nil
+	}
+	v := ev.Convert(basicTypes[ev.Kind()]).Interface()
+	return v, ev.Kind() == reflect.String || !ev.IsZero()
+}
+
+// basicTypes the predeclared type of the kind, named types (e.g. type Status int) are converted to it
+var basicTypes = map[reflect.Kind]reflect.Type{
+	reflect.String:  reflect.TypeOf(""),
+	reflect.Int:     reflect.TypeOf(int(0)),
+	reflect.Int8:    reflect.TypeOf(int8(0)),
+	reflect.Int16:   reflect.TypeOf(int16(0)),
+	reflect.Int32:   reflect.TypeOf(int32(0)),
+	reflect.Int64:   reflect.TypeOf(int64(0)),
+	reflect.Uint:    reflect.TypeOf(uint(0)),
+	reflect.Uint8:   reflect.TypeOf(uint8(0)),
+	reflect.Uint16:  reflect.TypeOf(uint16(0)),
+	reflect.Uint32:  reflect.TypeOf(uint32(0)),
+	reflect.Uint64:  reflect.TypeOf(uint64(0)),
+	reflect.Float32: reflect.TypeOf(float32(0)),
+	reflect.Float64: reflect.TypeOf(float64(0)),
+}
+
+func (cb *CondBuilder) filterBy(ff filterField, fv reflect.Value) {
+	if !fv.IsValid() {
+		return
+	}
+	// ⭐ A set pointer filters on its value, 0 / "" / false included, as Eq(k, &v) of the hand-written chain
+	set := fv.Kind() == reflect.Ptr
+	for fv.Kind() == reflect.Ptr {
+		if fv.IsNil() {
+			return
+		}
+		fv = fv.Elem()
+	}
+
+	if op, ok := filterCompareOps[ff.op]; ok {
+		if set {
+			cb.doGLEStrict(op, ff.column, fv.Interface())
+		} else {
+			cb.doGLE(op, ff.column, fv.Interface())
+		}
+		return
+	}
+
+	switch ff.op {
+	case "like":
+		cb.Like(ff.column, fv.String())
+	case "notlike":
+		cb.NotLike(ff.column, fv.String())
+	case "ilike":
+		cb.ILike(ff.column, fv.String())
+	case "likeleft":
+		cb.LikeLeft(ff.column, fv.String())
+	case "likeright":
+		cb.LikeRight(ff.column, fv.String())
+	case "in", "nin":
+		vs := make([]interface{}, 0, fv.Len())
+		for i := 0; i < fv.Len(); i++ {
+			if v, ok := filterInValue(fv.Index(i)); ok {
+				vs = append(vs, v)
+			}
+		}
+		if len(vs) == 0 {
+			return
+		}
+		op := IN
+		if ff.op == "nin" {
+			op = NIN
+		}
+		cb.bbs = append(cb.bbs, Bb{Op: op, Key: ff.column, Value: vs})
+	}
+}
@@ -23,6 +23,9 @@ func (built *Built) filterLast() *Bb {
 	if built.PageCondition.Rows == 0 {
 		panic("page.rows must be greater than 0")
 	}
+	if built.PageCondition.Keyset {
+		return built.keysetBb()
+	}
 	if built.PageCondition.Last > 0 {
 
 		if built.Sorts == nil || len(built.Sorts) == 0 {
@@ -0,0 +1,192 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type buyerFilter struct {
+	Name  string `xb:"like,name,or:kw"`
+	Level *int   `xb:"gte,level"`
+}
+
+type orderFilter struct {
+	Status  []string    `xb:"in,o.status"`
+	Since   *time.Time  `xb:"gte,o.created_at"`
+	MinAmt  *float64    `xb:"gt,o.amount"`
+	Remark  string      `xb:"like,o.remark,or:kw"`
+	Code    *string     `xb:"likeLeft,o.code"`
+	Sku     string      `xb:"likeRight,o.sku"`
+	Ignored string      `xb:"-"`
+	Buyer   buyerFilter `xb:"alias:u"`
+}
+
+func TestFilter_SameAsHandWrittenChain(t *testing.T) {
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	code := "SO"
+	req := orderFilter{
+		Status:  []string{"paid", "shipped"},
+		Since:   &since,
+		Remark:  "gift",
+		Code:    &code,
+		Sku:     "-XL",
+		Ignored: "x",
+		Buyer:   buyerFilter{Name: "tom", Level: Int(3)},
+	}
+
+	got := Of("orders").As("o").Filter(&req).Build()
+
+	want := Of("orders").As("o").
+		In("o.status", "paid", "shipped").
+		Gte("o.created_at", since).
+		And(func(cb *CondBuilder) {
+			cb.Like("o.remark", "gift").OR().Like("u.name", "tom")
+		}).
+		LikeLeft("o.code", "SO").
+		LikeRight("o.sku", "-XL").
+		Gte("u.level", 3).
+		Build()
+
+	if !reflect.DeepEqual(got.Conds, want.Conds) {
+		t.Fatalf("got:  %+v\nwant: %+v", got.Conds, want.Conds)
+	}
+
+	gotSql, gotArgs, _ := got.SqlOfSelect()
+	wantSql, wantArgs, _ := want.SqlOfSelect()
+	if gotSql != wantSql || !reflect.DeepEqual(gotArgs, wantArgs) {
+		t.Errorf("got:  %s %v\nwant: %s %v", gotSql, gotArgs, wantSql, wantArgs)
+	}
+}
+
+func TestFilter_SkipsNilAndZero(t *testing.T) {
+	built := Of("orders").As("o").Filter(&orderFilter{}).Build()
+
+	if len(built.Conds) != 0 {
+		t.Errorf("empty DTO should produce no conditions, got %+v", built.Conds)
+	}
+
+	// only one member of the OR group is set
+	built = Of("orders").As("o").Filter(orderFilter{Buyer: buyerFilter{Name: "tom"}}).Build()
+
+	sql, args, _ := built.SqlOfSelect()
+	if len(args) != 1 || args[0] != "%tom%" {
+		t.Errorf("unexpected args: %v (%s)", args, sql)
+	}
+}
+
+func TestFilter_SetPointerToZero(t *testing.T) {
+	type statusFilter struct {
+		Status *int    `xb:"eq,status"`
+		Name   *string `xb:"eq,name"`
+		Level  int     `xb:"gte,level"`
+	}
+	status := 0
+	name := ""
+
+	sql, args, _ := Of("users").Filter(&statusFilter{Status: &status, Name: &name}).Build().SqlOfSelect()
+
+	if sql != "SELECT * FROM users WHERE status = ? AND name = ?" {
+		t.Errorf("unexpected SQL: %s", sql)
+	}
+	if !reflect.DeepEqual(args, []interface{}{0, ""}) {
+		t.Errorf("unexpected args: %#v", args)
+	}
+
+	wantSql, _, _ := Of("users").Eq("status", &status).Build().SqlOfSelect()
+	gotSql, _, _ := Of("users").Filter(&statusFilter{Status: &status}).Build().SqlOfSelect()
+	if gotSql != wantSql {
+		t.Errorf("got:  %s\nwant: %s", gotSql, wantSql)
+	}
+}
+
+func TestFilter_InvalidTagPanics(t *testing.T) {
+	type badFilter struct {
+		Age int `xb:"like,age"`
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic of like on int field")
+		}
+	}()
+	Of("users").Filter(&badFilter{})
+}
+
+type orderStatus int
+
+type typedInFilter struct {
+	Status  []orderStatus `xb:"in,status"`
+	Skip    []*int64      `xb:"nin,id"`
+	Users   []uuid.UUID   `xb:"in,user_id"`
+	Days    []time.Time   `xb:"in,day"`
+	Buyer   *buyerFilter  `xb:"alias:u"`
+	Ignored []bool        `xb:"-"`
+}
+
+func TestFilter_TypedSlices(t *testing.T) {
+	day := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	u := uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	req := typedInFilter{
+		Status: []orderStatus{1, 0, 2},
+		Skip:   []*int64{Int64(7), nil},
+		Users:  []uuid.UUID{u, uuid.Nil},
+		Days:   []time.Time{day, {}},
+	}
+
+	sql, args, _ := Of("orders").Filter(&req).Build().SqlOfSelect()
+
+	if sql != "SELECT * FROM orders WHERE status IN (?, ?) AND id NOT IN (?) AND user_id IN (?) AND day IN (?)" {
+		t.Errorf("unexpected SQL: %s", sql)
+	}
+	want := []interface{}{1, 2, int64(7), u.String(), day}
+	if !reflect.DeepEqual(args, want) {
+		t.Errorf("unexpected args: %#v", args)
+	}
+}
+
+func TestFilter_PointerAlias(t *testing.T) {
+	// nil pointer of the nested struct is skipped
+	built := Of("orders").Filter(&typedInFilter{}).Build()
+	if len(built.Conds) != 0 {
+		t.Errorf("expected no conditions, got %+v", built.Conds)
+	}
+
+	built = Of("orders").Filter(&typedInFilter{Buyer: &buyerFilter{Level: Int(3)}}).Build()
+	sql, args, _ := built.SqlOfSelect()
+	if sql != "SELECT * FROM orders WHERE u.level >= ?" || len(args) != 1 {
+		t.Errorf("unexpected SQL: %s %v", sql, args)
+	}
+}
+
+func TestFilter_UnsupportedInElemPanics(t *testing.T) {
+	type badFilter struct {
+		Flags []bool `xb:"in,flag"`
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic of in on []bool field")
+		}
+	}()
+	Of("users").Filter(&badFilter{})
+}
@@ -17,10 +17,11 @@
 package xb
 
 type FromX struct {
-	tableName string
-	alia      string
-	join      *Join
-	sub       *BuilderX
+	tableName  string
+	alia       string
+	join       *Join
+	sub        *BuilderX
+	indexHints []string // ⭐ USE / FORCE / IGNORE INDEX (MySQL)
 }
 
 type FromBuilder struct {
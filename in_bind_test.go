@@ -0,0 +1,81 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package xb
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIn_BindsValuesAsArgs(t *testing.T) {
+	name := "x' OR '1'='1"
+	sql, args, _ := Of("users").
+		Eq("age", 18).
+		In("name", name, "bob").
+		Nin("id", 0, 3, Int64(4), (*int64)(nil)).
+		Build().
+		SqlOfSelect()
+
+	want := "SELECT * FROM users WHERE age = ? AND name IN (?, ?) AND id NOT IN (?, ?)"
+	if sql != want {
+		t.Fatalf("got:  %s\nwant: %s", sql, want)
+	}
+	wantArgs := []interface{}{18, name, "bob", 3, int64(4)}
+	if !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("args got %v, want %v", args, wantArgs)
+	}
+}
+
+func TestIn_AllValuesFiltered(t *testing.T) {
+	sql, args, _ := Of("users").In("id", 0, (*int)(nil)).Build().SqlOfSelect()
+	if sql != "SELECT * FROM users" || len(args) != 0 {
+		t.Errorf("expected no condition, got %s %v", sql, args)
+	}
+}
+
+func TestInArray_OneTypedArg(t *testing.T) {
+	sql, args, _ := Of("users").
+		InArray("id", 1, 2, 3).
+		NinArray("status", "deleted", "banned").
+		Build().
+		SqlOfSelect()
+
+	want := "SELECT * FROM users WHERE id = ANY(?) AND status <> ALL(?)"
+	if sql != want {
+		t.Fatalf("got:  %s\nwant: %s", sql, want)
+	}
+	if !reflect.DeepEqual(args, []interface{}{[]int{1, 2, 3}, []string{"deleted", "banned"}}) {
+		t.Errorf("unexpected args: %#v", args)
+	}
+}
+
+func TestIn_QdrantTypedValues(t *testing.T) {
+	built := Of("points").
+		Custom(NewQdrantBuilder().Build()).
+		In("id", 7, 8).
+		Build()
+
+	ids, _ := NewQdrantBuilder().Build().extractIdsOrFilter(built.Conds)
+	if !reflect.DeepEqual(ids, []interface{}{7, 8}) {
+		t.Errorf("expected typed ids, got %#v", ids)
+	}
+
+	cond, err := bbToQdrantCondition(built.Conds[0])
+	if err != nil || cond.Match == nil || !reflect.DeepEqual(cond.Match.Any, []interface{}{7, 8}) {
+		t.Errorf("expected match.any of typed values, got %+v %v", cond, err)
+	}
+}
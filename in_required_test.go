@@ -18,6 +18,7 @@
 package xb
 
 import (
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -122,27 +123,31 @@ func TestInRequired_ValidValues(t *testing.T) {
 		name      string
 		buildFunc func() *Built
 		wantSQL   string
+		wantArgs  []interface{}
 	}{
 		{
 			name: "Single valid int",
 			buildFunc: func() *Built {
 				return Of("users").InRequired("id", 1).Build()
 			},
-			wantSQL: "WHERE id IN (1)",
+			wantSQL:  "WHERE id IN (?)",
+			wantArgs: []interface{}{1},
 		},
 		{
 			name: "Multiple ints",
 			buildFunc: func() *Built {
 				return Of("users").InRequired("id", 1, 2, 3).Build()
 			},
-			wantSQL: "WHERE id IN (1, 2, 3)",
+			wantSQL:  "WHERE id IN (?, ?, ?)",
+			wantArgs: []interface{}{1, 2, 3},
 		},
 		{
 			name: "Multiple strings",
 			buildFunc: func() *Built {
 				return Of("users").InRequired("status", "active", "pending").Build()
 			},
-			wantSQL: "WHERE status IN ('active', 'pending')",
+			wantSQL:  "WHERE status IN (?, ?)",
+			wantArgs: []interface{}{"active", "pending"},
 		},
 		{
 			name: "Slice spread",
@@ -150,18 +155,22 @@ func TestInRequired_ValidValues(t *testing.T) {
 				ids := []interface{}{1, 2, 3}
 				return Of("users").InRequired("id", ids...).Build()
 			},
-			wantSQL: "WHERE id IN (1, 2, 3)",
+			wantSQL:  "WHERE id IN (?, ?, ?)",
+			wantArgs: []interface{}{1, 2, 3},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			built := tt.buildFunc()
-			sql, _, _ := built.SqlOfSelect()
+			sql, args, _ := built.SqlOfSelect()
 
 			if !strings.Contains(sql, tt.wantSQL) {
 				t.Errorf("SQL doesn't contain expected clause.\nGot: %s\nWant to contain: %s", sql, tt.wantSQL)
 			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("Args mismatch.\nGot: %v\nWant: %v", args, tt.wantArgs)
+			}
 			t.Logf("✅ Valid SQL: %s", sql)
 		})
 	}
@@ -215,9 +224,9 @@ func TestInRequired_RealWorldScenario(t *testing.T) {
 		// ✅ User selected orders
 		selectedOrderIDs := []interface{}{101, 102, 103}
 		built := Of("orders").InRequired("id", selectedOrderIDs...).Build()
-		sql, _, _ := built.SqlOfSelect()
+		sql, args, _ := built.SqlOfSelect()
 
-		if !strings.Contains(sql, "WHERE id IN (101, 102, 103)") {
+		if !strings.Contains(sql, "WHERE id IN (?, ?, ?)") || len(args) != 3 {
 			t.Errorf("Should generate correct WHERE clause.\nGot: %s", sql)
 		}
 		t.Logf("✅ Admin deletes selected orders: %s", sql)
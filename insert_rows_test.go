@@ -0,0 +1,230 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestInsertRows_UnionColumnsAndNullFill(t *testing.T) {
+	built := Of("users").
+		InsertRows(func(rb *RowsBuilder) {
+			rb.Row(func(ib *InsertBuilder) {
+				ib.Set("name", "Alice").Set("age", 18)
+			})
+			rb.Row(func(ib *InsertBuilder) {
+				ib.Set("name", "Bob").Set("email", "bob@x.io")
+			})
+			rb.Row(func(ib *InsertBuilder) {
+				ib.Set("name", nil)
+			})
+		}).
+		Build()
+
+	sql, args := built.SqlOfInsert()
+
+	want := "INSERT INTO users (name, age, email) VALUES (?, ?, NULL), (?, NULL, ?)"
+	if sql != want {
+		t.Fatalf("got:  %s\nwant: %s", sql, want)
+	}
+	wantArgs := []interface{}{"Alice", 18, "Bob", "bob@x.io"}
+	if !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("got args %v, want %v", args, wantArgs)
+	}
+}
+
+func TestInsertRows_FillDefault(t *testing.T) {
+	built := Of("users").
+		InsertRows(func(rb *RowsBuilder) {
+			rb.FillDefault()
+			rb.Row(func(ib *InsertBuilder) {
+				ib.Set("name", "Alice")
+			})
+			rb.Row(func(ib *InsertBuilder) {
+				ib.Set("age", 20)
+			})
+		}).
+		Build()
+
+	sql, _ := built.SqlOfInsert()
+	if sql != "INSERT INTO users (name, age) VALUES (?, DEFAULT), (DEFAULT, ?)" {
+		t.Errorf("unexpected SQL: %s", sql)
+	}
+}
+
+func TestInsertRows_ChunkByMaxParams(t *testing.T) {
+	built := Of("users").
+		Custom(DefaultPostgresCustom()).
+		InsertRows(func(rb *RowsBuilder) {
+			rb.MaxParams(4)
+			for i := 1; i <= 5; i++ {
+				id := i
+				rb.Row(func(ib *InsertBuilder) {
+					ib.Set("id", id).Set("name", "u")
+				})
+			}
+		}).
+		Build()
+
+	results := built.SqlOfInsertBatch()
+
+	if len(results) != 3 {
+		t.Fatalf("expected 3 statements, got %d", len(results))
+	}
+	if results[0].SQL != "INSERT INTO users (id, name) VALUES ($1, $2), ($3, $4)" {
+		t.Errorf("unexpected first chunk: %s", results[0].SQL)
+	}
+	if results[2].SQL != "INSERT INTO users (id, name) VALUES ($1, $2)" {
+		t.Errorf("unexpected last chunk: %s", results[2].SQL)
+	}
+	if !reflect.DeepEqual(results[1].Args, []interface{}{3, "u", 4, "u"}) {
+		t.Errorf("unexpected args of second chunk: %v", results[1].Args)
+	}
+
+	// one statement without chunking
+	sql, args := built.SqlOfInsert()
+	if len(args) != 10 || !strings.HasSuffix(sql, "($9, $10)") {
+		t.Errorf("unexpected single statement: %s %v", sql, args)
+	}
+}
+
+func TestInsertRows_DialectLimits(t *testing.T) {
+	rows := func(c Custom, n int, cols int) []*SQLResult {
+		return Of("users").
+			Custom(c).
+			InsertRows(func(rb *RowsBuilder) {
+				for i := 1; i <= n; i++ {
+					id := i
+					rb.Row(func(ib *InsertBuilder) {
+						ib.Set("id", id)
+						for j := 1; j < cols; j++ {
+							ib.Set("c"+strconv.Itoa(j), j)
+						}
+					})
+				}
+			}).
+			Build().
+			SqlOfInsertBatch()
+	}
+
+	// SQL Server: at most 1000 rows and 2100 parameters
+	results := rows(DefaultSQLServerCustom(), 1500, 1)
+	if len(results) != 2 || len(results[0].Args) != 1000 || len(results[1].Args) != 500 {
+		t.Errorf("unexpected chunks of 1 column: %d", len(results))
+	}
+	results = rows(DefaultSQLServerCustom(), 1500, 3)
+	if len(results) != 3 || len(results[0].Args) != 2100 || len(results[2].Args) != 300 {
+		t.Errorf("unexpected chunks of 3 columns: %d", len(results))
+	}
+	if !strings.HasSuffix(results[0].SQL, "(@p2098, @p2099, @p2100)") {
+		t.Errorf("unexpected last row of chunk: %s", results[0].SQL[len(results[0].SQL)-40:])
+	}
+
+	// SQLite: 999 parameters
+	results = rows(DefaultSQLiteCustom(), 600, 2)
+	if len(results) != 2 || len(results[0].Args) != 998 {
+		t.Errorf("unexpected SQLite chunks: %d", len(results))
+	}
+
+	// PostgreSQL: 65535 parameters
+	if results = rows(DefaultPostgresCustom(), 1500, 3); len(results) != 1 {
+		t.Errorf("unexpected PostgreSQL chunks: %d", len(results))
+	}
+}
+
+func TestInsertRows_PostgresOnConflict(t *testing.T) {
+	built := Of("users").
+		Custom(NewPostgresBuilder().OnConflict("id").DoUpdate().Build()).
+		InsertRows(func(rb *RowsBuilder) {
+			rb.Row(func(ib *InsertBuilder) {
+				ib.Set("id", 1).Set("name", "Alice")
+			})
+			rb.Row(func(ib *InsertBuilder) {
+				ib.Set("id", 2).Set("age", 30)
+			})
+		}).
+		Build()
+
+	sql, _ := built.SqlOfInsert()
+
+	want := "INSERT INTO users (id, name, age) VALUES ($1, $2, NULL), ($3, NULL, $4)" +
+		" ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name, age = EXCLUDED.age"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+}
+
+func TestInsertRows_OracleInsertAll(t *testing.T) {
+	built := Of("users").
+		Custom(DefaultOracleCustom()).
+		InsertRows(func(rb *RowsBuilder) {
+			rb.Row(func(ib *InsertBuilder) {
+				ib.Set("id", 1).Set("name", "Alice")
+			})
+			rb.Row(func(ib *InsertBuilder) {
+				ib.Set("id", 2)
+			})
+		}).
+		Build()
+
+	sql, args := built.SqlOfInsert()
+
+	want := "INSERT ALL INTO users (id, name) VALUES (:1, :2) INTO users (id, name) VALUES (:3, NULL) SELECT 1 FROM DUAL"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+	if len(args) != 3 {
+		t.Errorf("expected 3 args, got %v", args)
+	}
+}
+
+func TestInsertRows_QdrantPoints(t *testing.T) {
+	built := Of("code_vectors").
+		Custom(NewQdrantBuilder().Build()).
+		InsertRows(func(rb *RowsBuilder) {
+			rb.Row(func(ib *InsertBuilder) {
+				ib.Set("id", 1).Set("vector", []float32{0.1, 0.2}).Set("language", "go")
+			})
+			rb.Row(func(ib *InsertBuilder) {
+				ib.Set("id", 2).Set("vector", []float32{0.3, 0.4})
+			})
+		}).
+		Build()
+
+	jsonStr, err := built.JsonOfInsert()
+	if err != nil {
+		t.Fatalf("JsonOfInsert failed: %v", err)
+	}
+
+	var req struct {
+		Points []map[string]interface{} `json:"points"`
+	}
+	if err := json.Unmarshal([]byte(jsonStr), &req); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if len(req.Points) != 2 {
+		t.Fatalf("expected 2 points, got %d: %s", len(req.Points), jsonStr)
+	}
+	if req.Points[1]["id"] != float64(2) {
+		t.Errorf("unexpected second point: %v", req.Points[1])
+	}
+}
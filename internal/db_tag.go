@@ -0,0 +1,78 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package internal
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// DbField column of a `db:"name,omitempty,pk"` tagged field
+type DbField struct {
+	Index     []int
+	Column    string
+	OmitEmpty bool
+	Pk        bool
+}
+
+// dbFieldsCache reflect.Type → []DbField
+var dbFieldsCache sync.Map
+
+// DbFieldsOf gets the db tagged fields of the struct type (cached per type), shared by xb and xdb
+// Anonymous embedded structs without tag are walked as fields of the outer struct
+func DbFieldsOf(t reflect.Type) []DbField {
+	if cached, ok := dbFieldsCache.Load(t); ok {
+		return cached.([]DbField)
+	}
+	fields := walkDbFields(t, nil)
+	cached, _ := dbFieldsCache.LoadOrStore(t, fields)
+	return cached.([]DbField)
+}
+
+func walkDbFields(t reflect.Type, parent []int) []DbField {
+	fields := []DbField{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		index := append(append([]int(nil), parent...), i)
+		tag, tagged := f.Tag.Lookup("db")
+		if !tagged {
+			if f.Anonymous && f.Type.Kind() == reflect.Struct {
+				fields = append(fields, walkDbFields(f.Type, index)...)
+			}
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		parts := strings.Split(tag, ",")
+		if parts[0] == "-" || parts[0] == "" {
+			continue
+		}
+		df := DbField{Index: index, Column: parts[0]}
+		for _, opt := range parts[1:] {
+			switch strings.TrimSpace(opt) {
+			case "omitempty":
+				df.OmitEmpty = true
+			case "pk":
+				df.Pk = true
+			}
+		}
+		fields = append(fields, df)
+	}
+	return fields
+}
@@ -35,6 +35,7 @@ const (
 	ON_SCRIPT             = " ON "
 	USING_SCRIPT_LEFT     = " USING("
 	PLACE_HOLDER          = " ?"
+	PLACE_HOLDER_MARK     = "?"
 	COMMA                 = ", "
 	DOT                   = "."
 	STAR                  = "* "
@@ -24,6 +24,7 @@ const (
 	asof_join       = "ASOF JOIN"
 	global_join     = "GLOBAL JOIN"
 	full_outer_join = "FULL OUTER JOIN"
+	straight_join   = "STRAIGHT_JOIN"
 )
 
 /**
@@ -62,3 +63,8 @@ func GLOBAL() string {
 func FULL_OUTER() string {
 	return full_outer_join
 }
+
+// STRAIGHT STRAIGHT_JOIN, the left table is read first (MySQL), INNER JOIN for the other Customs
+func STRAIGHT() string {
+	return straight_join
+}
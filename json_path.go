@@ -0,0 +1,483 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	. "github.com/fndome/xb/internal"
+)
+
+// jsonPathRegex a.b, items[0].id, keys are plain identifiers (the path is written into SQL)
+var jsonPathRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\[[0-9]+\])*(\.[A-Za-z_][A-Za-z0-9_]*(\[[0-9]+\])*)*$`)
+
+// JSON path ops, besides EQ / NE / GT / GTE / LT / LTE / IN
+const (
+	jsonContains = "CONTAINS"
+	jsonHasKey   = "HAS_KEY"
+)
+
+type jsonPathCond struct {
+	op    string
+	path  string   // a.b[0].c
+	segs  []string // a, b, 0, c (indexes are digits)
+	value interface{}
+	doc   string // JSON of the value of Contains()
+}
+
+// JsonPathBuilder conditions on the value at path of a JSON column, see JsonPath()
+type JsonPathBuilder struct {
+	cb   *CondBuilder
+	col  string
+	path string
+}
+
+// JsonPath conditions on the value at path of the JSON column: Eq / Ne / Gt / Gte / Lt / Lte / In / Contains / HasKey
+// nil/0/"" values are ignored as Eq() / In()
+// Rendered by the dialect of Custom:
+//   - MySQL (default): JSON_EXTRACT(payload, '$.a.b') = ?
+//   - PostgreSQL:      payload->'a'->>'b' = ?, cast to numeric / boolean for number / bool values
+//   - SQLite:          json_extract(payload, '$.a.b') = ?
+//   - Qdrant:          key payload.a.b (payload.items[].qty for items[0].qty, Qdrant matches any element)
+//
+// Oracle / SQL Server / ClickHouse Customs return DialectError
+//
+// Example:
+//
+//	xb.Of("t_event").
+//	    JsonPath("payload", "user.tier").Eq("gold").
+//	    JsonPath("payload", "items[0].qty").Gt(2).
+//	    Build()
+func (cb *CondBuilder) JsonPath(col string, path string) *JsonPathBuilder {
+	if col == "" {
+		panic("JsonPath(col, path), col can not be blank")
+	}
+	if path != "" && !jsonPathRegex.MatchString(path) {
+		panic("JsonPath(col, path), invalid path: " + path)
+	}
+	return &JsonPathBuilder{cb: cb, col: col, path: path}
+}
+
+func (jp *JsonPathBuilder) Eq(v interface{}) *CondBuilder {
+	return jp.doGLE(EQ, v)
+}
+func (jp *JsonPathBuilder) Ne(v interface{}) *CondBuilder {
+	return jp.doGLE(NE, v)
+}
+func (jp *JsonPathBuilder) Gt(v interface{}) *CondBuilder {
+	return jp.doGLE(GT, v)
+}
+func (jp *JsonPathBuilder) Gte(v interface{}) *CondBuilder {
+	return jp.doGLE(GTE, v)
+}
+func (jp *JsonPathBuilder) Lt(v interface{}) *CondBuilder {
+	return jp.doGLE(LT, v)
+}
+func (jp *JsonPathBuilder) Lte(v interface{}) *CondBuilder {
+	return jp.doGLE(LTE, v)
+}
+
+// In the value at path IN (?, ?), nil/0/"" values are filtered as In()
+// JSON booleans (true and false) and json.Number are kept
+func (jp *JsonPathBuilder) In(vs ...interface{}) *CondBuilder {
+	arr := jsonInValues(vs)
+	if len(arr) == 0 {
+		return jp.cb
+	}
+	return jp.add(jsonPathCond{op: IN, value: arr})
+}
+
+// Contains the JSON at path (the whole column if path is "") contains v, skipped if v is nil or an empty slice
+//   - MySQL (default): JSON_CONTAINS(tags, ?, '$.a'), v is bound as JSON
+//   - PostgreSQL:      tags @> ?::jsonb, v is bound as JSON (nested by path, GIN index friendly)
+//   - SQLite:          EXISTS (SELECT 1 FROM json_each(tags, '$.a') WHERE value = ?), scalars or slices of scalars only
+//   - Qdrant:          match.value of key tags.a (each element of slices)
+//
+// Example:
+//
+//	xb.Of("t_post").JsonPath("meta", "tags").Contains([]string{"go", "sql"}).Build()
+func (jp *JsonPathBuilder) Contains(v interface{}) *CondBuilder {
+	if v == nil {
+		return jp.cb
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Slice && rv.Len() == 0 {
+		return jp.cb
+	}
+	doc, err := json.Marshal(v)
+	if err != nil {
+		panic(fmt.Sprintf("JsonPath(col, path).Contains(v), marshal v: %v", err))
+	}
+	return jp.add(jsonPathCond{op: jsonContains, value: v, doc: string(doc)})
+}
+
+// HasKey the key at path exists (JSON null included)
+//   - MySQL (default): JSON_CONTAINS_PATH(payload, 'one', '$.a.b')
+//   - PostgreSQL:      payload->'a' ? 'b' (GIN index friendly)
+//   - SQLite:          json_type(payload, '$.a.b') IS NOT NULL
+//   - Qdrant:          must_not is_empty of key payload.a.b
+func (jp *JsonPathBuilder) HasKey() *CondBuilder {
+	if jp.path == "" {
+		panic("JsonPath(col, path).HasKey(), path can not be blank")
+	}
+	return jp.add(jsonPathCond{op: jsonHasKey})
+}
+
+// jsonInValues the values of In(): bool and json.Number as JSON scalars, the others as In()
+func jsonInValues(vs []interface{}) []interface{} {
+	arr := make([]interface{}, 0, len(vs))
+	for _, v := range vs {
+		switch x := v.(type) {
+		case bool:
+			arr = append(arr, x)
+		case json.Number:
+			if n, err := x.Int64(); err == nil {
+				arr = append(arr, inValues([]interface{}{n})...)
+			} else if f, err := x.Float64(); err == nil {
+				arr = append(arr, inValues([]interface{}{f})...)
+			}
+		default:
+			arr = append(arr, inValues([]interface{}{v})...)
+		}
+	}
+	return arr
+}
+
+func (jp *JsonPathBuilder) doGLE(op string, v interface{}) *CondBuilder {
+	v, ok := jp.cb.filterValue(v)
+	if !ok {
+		return jp.cb
+	}
+	return jp.add(jsonPathCond{op: op, value: v})
+}
+
+func (jp *JsonPathBuilder) add(jc jsonPathCond) *CondBuilder {
+	jc.path = jp.path
+	if jc.path != "" {
+		jc.segs = strings.FieldsFunc(jc.path, func(r rune) bool {
+			return r == '.' || r == '[' || r == ']'
+		})
+	}
+	jp.cb.bbs = append(jp.cb.bbs, Bb{
+		Op:    JSON_PATH,
+		Key:   jp.col,
+		Value: jc,
+	})
+	return jp.cb
+}
+
+// JsonPathX JsonPathBuilder of BuilderX, see CondBuilder.JsonPath()
+type JsonPathX struct {
+	x  *BuilderX
+	jp *JsonPathBuilder
+}
+
+func (x *BuilderX) JsonPath(col string, path string) *JsonPathX {
+	return &JsonPathX{x: x, jp: x.CondBuilder.JsonPath(col, path)}
+}
+
+func (j *JsonPathX) Eq(v interface{}) *BuilderX {
+	j.jp.Eq(v)
+	return j.x
+}
+func (j *JsonPathX) Ne(v interface{}) *BuilderX {
+	j.jp.Ne(v)
+	return j.x
+}
+func (j *JsonPathX) Gt(v interface{}) *BuilderX {
+	j.jp.Gt(v)
+	return j.x
+}
+func (j *JsonPathX) Gte(v interface{}) *BuilderX {
+	j.jp.Gte(v)
+	return j.x
+}
+func (j *JsonPathX) Lt(v interface{}) *BuilderX {
+	j.jp.Lt(v)
+	return j.x
+}
+func (j *JsonPathX) Lte(v interface{}) *BuilderX {
+	j.jp.Lte(v)
+	return j.x
+}
+func (j *JsonPathX) In(vs ...interface{}) *BuilderX {
+	j.jp.In(vs...)
+	return j.x
+}
+func (j *JsonPathX) Contains(v interface{}) *BuilderX {
+	j.jp.Contains(v)
+	return j.x
+}
+func (j *JsonPathX) HasKey() *BuilderX {
+	j.jp.HasKey()
+	return j.x
+}
+
+func (built *Built) toJsonPathSql(bb Bb, bp *strings.Builder, vs *[]interface{}) {
+	jc := bb.Value.(jsonPathCond)
+	var args []interface{}
+	switch jc.op {
+	case jsonHasKey:
+		switch built.dialect {
+		case dialectPostgres:
+			// ⭐ ?? is written as the ? operator by numberPlaceholders
+			last := len(jc.segs) - 1
+			if isJsonIndex(jc.segs[last]) {
+				bp.WriteString(pgJsonArrow(bb.Key, jc.segs, false))
+				bp.WriteString(" IS NOT NULL")
+			} else {
+				bp.WriteString(pgJsonArrow(bb.Key, jc.segs[:last], false))
+				bp.WriteString(" ?? '" + jc.segs[last] + "'")
+			}
+		case dialectSQLite:
+			bp.WriteString("json_type(" + bb.Key + ", '" + jsonDollarPath(jc.path) + "') IS NOT NULL")
+		default:
+			bp.WriteString("JSON_CONTAINS_PATH(" + bb.Key + ", 'one', '" + jsonDollarPath(jc.path) + "')")
+		}
+	case jsonContains:
+		args = built.toJsonContainsSql(bb.Key, jc, bp)
+	default:
+		bp.WriteString(built.jsonValueSql(bb.Key, jc))
+		bp.WriteString(SPACE)
+		bp.WriteString(jc.op)
+		if jc.op == IN {
+			args = jc.value.([]interface{})
+			bp.WriteString(SPACE)
+			bp.WriteString(BEGIN_SUB)
+			for i := range args {
+				if i > 0 {
+					bp.WriteString(COMMA)
+				}
+				bp.WriteString(PLACE_HOLDER_MARK)
+			}
+			bp.WriteString(END_SUB)
+		} else {
+			bp.WriteString(PLACE_HOLDER)
+			args = []interface{}{jc.value}
+		}
+	}
+	if vs != nil {
+		*vs = append(*vs, args...)
+	}
+}
+
+// jsonValueSql the scalar at path, compared with the bound values
+func (built *Built) jsonValueSql(col string, jc jsonPathCond) string {
+	switch built.dialect {
+	case dialectPostgres:
+		expr := pgJsonArrow(col, jc.segs, true)
+		v := jc.value
+		if arr, ok := v.([]interface{}); ok && len(arr) > 0 {
+			v = arr[0]
+		}
+		switch v.(type) {
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+			return "(" + expr + ")::numeric"
+		case bool:
+			return "(" + expr + ")::boolean"
+		}
+		return expr
+	case dialectSQLite:
+		return "json_extract(" + col + ", '" + jsonDollarPath(jc.path) + "')"
+	default:
+		return "JSON_EXTRACT(" + col + ", '" + jsonDollarPath(jc.path) + "')"
+	}
+}
+
+func (built *Built) toJsonContainsSql(col string, jc jsonPathCond, bp *strings.Builder) []interface{} {
+	switch built.dialect {
+	case dialectPostgres:
+		if jc.path == "" || !hasJsonIndex(jc.segs) {
+			// ⭐ {"a": {"b": v}} on the whole column, GIN index friendly
+			bp.WriteString(col)
+			bp.WriteString(" @> ?::jsonb")
+			return []interface{}{nestJsonDoc(jc.segs, jc.doc)}
+		}
+		bp.WriteString(pgJsonArrow(col, jc.segs, false))
+		bp.WriteString(" @> ?::jsonb")
+		return []interface{}{jc.doc}
+	case dialectSQLite:
+		source := col
+		if jc.path != "" {
+			source = col + ", '" + jsonDollarPath(jc.path) + "'"
+		}
+		elems := jsonScalars(jc.value)
+		if len(elems) > 1 {
+			bp.WriteString(BEGIN_SUB)
+		}
+		for i := range elems {
+			if i > 0 {
+				bp.WriteString(AND_SCRIPT)
+			}
+			bp.WriteString("EXISTS (SELECT 1 FROM json_each(" + source + ") WHERE value = ?)")
+		}
+		if len(elems) > 1 {
+			bp.WriteString(END_SUB)
+		}
+		return elems
+	default:
+		bp.WriteString("JSON_CONTAINS(" + col + ", ?")
+		if jc.path != "" {
+			bp.WriteString(", '" + jsonDollarPath(jc.path) + "'")
+		}
+		bp.WriteString(")")
+		return []interface{}{jc.doc}
+	}
+}
+
+// jsonDollarPath a.b[0] → $.a.b[0]
+func jsonDollarPath(path string) string {
+	if path == "" {
+		return "$"
+	}
+	return "$." + path
+}
+
+// pgJsonArrow payload->'a'->>'b' (text of the last, if asText)
+func pgJsonArrow(col string, segs []string, asText bool) string {
+	sb := strings.Builder{}
+	sb.WriteString(col)
+	for i, seg := range segs {
+		if asText && i == len(segs)-1 {
+			sb.WriteString("->>")
+		} else {
+			sb.WriteString("->")
+		}
+		if isJsonIndex(seg) {
+			sb.WriteString(seg)
+		} else {
+			sb.WriteString("'" + seg + "'")
+		}
+	}
+	return sb.String()
+}
+
+func isJsonIndex(seg string) bool {
+	return seg != "" && seg[0] >= '0' && seg[0] <= '9'
+}
+
+func hasJsonIndex(segs []string) bool {
+	for _, seg := range segs {
+		if isJsonIndex(seg) {
+			return true
+		}
+	}
+	return false
+}
+
+// nestJsonDoc wraps doc by the keys: a, b → {"a":{"b":doc}}
+func nestJsonDoc(segs []string, doc string) string {
+	for i := len(segs) - 1; i >= 0; i-- {
+		key, _ := json.Marshal(segs[i])
+		doc = "{" + string(key) + ":" + doc + "}"
+	}
+	return doc
+}
+
+// jsonScalars the elements of a slice, or the scalar itself; nil if v is an object
+func jsonScalars(v interface{}) []interface{} {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return nil
+		}
+		arr := make([]interface{}, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			e := rv.Index(i).Interface()
+			if isJsonObject(e) {
+				return nil
+			}
+			arr = append(arr, e)
+		}
+		return arr
+	}
+	if isJsonObject(v) {
+		return nil
+	}
+	return []interface{}{v}
+}
+
+func isJsonObject(v interface{}) bool {
+	if v == nil {
+		return false
+	}
+	switch reflect.Indirect(reflect.ValueOf(v)).Kind() {
+	case reflect.Map, reflect.Struct, reflect.Slice, reflect.Array:
+		return true
+	}
+	return false
+}
+
+// qdrantJsonKey nested key of Qdrant: payload.a.b, payload.items[].qty
+func qdrantJsonKey(col string, segs []string) string {
+	sb := strings.Builder{}
+	sb.WriteString(col)
+	for _, seg := range segs {
+		if isJsonIndex(seg) {
+			sb.WriteString("[]")
+		} else {
+			sb.WriteString(".")
+			sb.WriteString(seg)
+		}
+	}
+	return sb.String()
+}
+
+// jsonPathError DialectError of the dialects without JSON path conditions
+func (built *Built) jsonPathError(dialect string) error {
+	for _, bbs := range [][]Bb{built.Conds, built.Havings} {
+		if findBb(bbs, func(bb Bb) bool { return bb.Op == JSON_PATH }) != nil {
+			return &DialectError{Dialect: dialect, Reason: "JSON path conditions are not supported"}
+		}
+	}
+	return nil
+}
+
+// findBb the first bb matched, And() / Or() included
+func findBb(bbs []Bb, f func(bb Bb) bool) *Bb {
+	for i := range bbs {
+		if f(bbs[i]) {
+			return &bbs[i]
+		}
+		if sub := findBb(bbs[i].Subs, f); sub != nil {
+			return sub
+		}
+	}
+	return nil
+}
+
+// jsonContainsError DialectError of JsonPath().Contains() of objects on SQLite
+func (built *Built) jsonContainsError(dialect string) error {
+	for _, bbs := range [][]Bb{built.Conds, built.Havings} {
+		bb := findBb(bbs, func(bb Bb) bool {
+			if bb.Op != JSON_PATH {
+				return false
+			}
+			jc := bb.Value.(jsonPathCond)
+			return jc.op == jsonContains && len(jsonScalars(jc.value)) == 0
+		})
+		if bb != nil {
+			return &DialectError{Dialect: dialect, Reason: "JsonPath().Contains() of objects is not supported, use scalars or slices of scalars"}
+		}
+	}
+	return nil
+}
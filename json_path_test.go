@@ -0,0 +1,182 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestJsonPath_Dialects(t *testing.T) {
+	build := func(c Custom) (string, []interface{}) {
+		sql, args, _ := Of("t_event").Custom(c).
+			JsonPath("payload", "user.tier").Eq("gold").
+			JsonPath("payload", "items[0].qty").Gt(2).
+			JsonPath("payload", "source").In("web", "app").
+			JsonPath("payload", "user.email").HasKey().
+			Build().
+			SqlOfSelect()
+		return sql, args
+	}
+
+	cases := []struct {
+		name   string
+		custom Custom
+		want   string
+	}{
+		{"MySQL", NewMySQLBuilder().Build(),
+			"SELECT * FROM t_event WHERE JSON_EXTRACT(payload, '$.user.tier') = ? AND JSON_EXTRACT(payload, '$.items[0].qty') > ? " +
+				"AND JSON_EXTRACT(payload, '$.source') IN (?, ?) AND JSON_CONTAINS_PATH(payload, 'one', '$.user.email')"},
+		{"PostgreSQL", NewPostgresBuilder().Build(),
+			"SELECT * FROM t_event WHERE payload->'user'->>'tier' = $1 AND (payload->'items'->0->>'qty')::numeric > $2 " +
+				"AND payload->>'source' IN ($3, $4) AND payload->'user' ? 'email'"},
+		{"SQLite", NewSQLiteBuilder().Build(),
+			"SELECT * FROM t_event WHERE json_extract(payload, '$.user.tier') = ? AND json_extract(payload, '$.items[0].qty') > ? " +
+				"AND json_extract(payload, '$.source') IN (?, ?) AND json_type(payload, '$.user.email') IS NOT NULL"},
+	}
+	for _, c := range cases {
+		sql, args := build(c.custom)
+		if sql != c.want {
+			t.Errorf("%s\ngot:  %s\nwant: %s", c.name, sql, c.want)
+		}
+		if !reflect.DeepEqual(args, []interface{}{"gold", 2, "web", "app"}) {
+			t.Errorf("%s args: %v", c.name, args)
+		}
+	}
+}
+
+func TestJsonPath_PostgresHasKey(t *testing.T) {
+	sql, args, _ := Of("t_event").Custom(NewPostgresBuilder().Build()).
+		JsonPath("payload", "email").HasKey().
+		JsonPath("payload", "items[1]").HasKey().
+		JsonPath("payload", "user.tier").Eq("gold").
+		Build().
+		SqlOfSelect()
+	want := "SELECT * FROM t_event WHERE payload ? 'email' AND payload->'items'->1 IS NOT NULL AND payload->'user'->>'tier' = $1"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+	if !reflect.DeepEqual(args, []interface{}{"gold"}) {
+		t.Errorf("args: %v", args)
+	}
+}
+
+func TestJsonPath_InBoolAndNumber(t *testing.T) {
+	sql, args, _ := Of("t_event").Custom(NewPostgresBuilder().Build()).
+		JsonPath("meta", "flag").In(true, false).
+		JsonPath("meta", "level").In(json.Number("3"), json.Number("0"), json.Number("1.5")).
+		Build().
+		SqlOfSelect()
+	want := "SELECT * FROM t_event WHERE (meta->>'flag')::boolean IN ($1, $2) AND (meta->>'level')::numeric IN ($3, $4)"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+	if !reflect.DeepEqual(args, []interface{}{true, false, int64(3), 1.5}) {
+		t.Errorf("args: %#v", args)
+	}
+}
+
+func TestJsonPath_Contains(t *testing.T) {
+	build := func(c Custom) (string, []interface{}) {
+		sql, args, _ := Of("t_post").Custom(c).
+			JsonPath("meta", "tags").Contains([]string{"go", "sql"}).
+			Build().
+			SqlOfSelect()
+		return sql, args
+	}
+
+	sql, args := build(NewMySQLBuilder().Build())
+	if sql != "SELECT * FROM t_post WHERE JSON_CONTAINS(meta, ?, '$.tags')" || args[0] != `["go","sql"]` {
+		t.Errorf("MySQL: %s %v", sql, args)
+	}
+
+	sql, args = build(NewPostgresBuilder().Build())
+	if sql != "SELECT * FROM t_post WHERE meta @> $1::jsonb" || args[0] != `{"tags":["go","sql"]}` {
+		t.Errorf("PostgreSQL: %s %v", sql, args)
+	}
+
+	sql, args = build(NewSQLiteBuilder().Build())
+	want := "SELECT * FROM t_post WHERE (EXISTS (SELECT 1 FROM json_each(meta, '$.tags') WHERE value = ?) " +
+		"AND EXISTS (SELECT 1 FROM json_each(meta, '$.tags') WHERE value = ?))"
+	if sql != want || !reflect.DeepEqual(args, []interface{}{"go", "sql"}) {
+		t.Errorf("SQLite: %s %v", sql, args)
+	}
+
+	built := Of("t_post").JsonPath("meta", "").Contains(map[string]int{"v": 1}).Build()
+	if _, err := NewSQLiteBuilder().Build().Generate(built); err == nil {
+		t.Error("SQLite Contains() of objects should return DialectError")
+	}
+	if _, err := NewOracleBuilder().Build().Generate(built); err == nil {
+		t.Error("Oracle should return DialectError")
+	}
+}
+
+func TestJsonPath_SkipsAndPanics(t *testing.T) {
+	sql, _, _ := Of("t_event").
+		JsonPath("payload", "a").Eq("").
+		JsonPath("payload", "b").Gt(0).
+		JsonPath("payload", "c").In().
+		JsonPath("payload", "d").Contains([]string{}).
+		Build().
+		SqlOfSelect()
+	if sql != "SELECT * FROM t_event" {
+		t.Errorf("unexpected: %s", sql)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("invalid path should panic")
+		}
+	}()
+	Of("t_event").JsonPath("payload", "a'); DROP TABLE x; --").Eq(1)
+}
+
+func TestJsonPath_QdrantNestedKeys(t *testing.T) {
+	built := Of(&CodeVector{}).
+		Custom(NewQdrantBuilder().Build()).
+		VectorSearch("embedding", Vector{0.1, 0.2, 0.3}, 10).
+		JsonPath("meta", "repo.owner").Eq("fndome").
+		JsonPath("meta", "stars").Gte(100).
+		JsonPath("meta", "tags").Contains([]string{"go", "sql"}).
+		JsonPath("meta", "license").HasKey().
+		JsonPath("meta", "items[0].qty").Gt(2).
+		Build()
+
+	jsonStr, err := built.JsonOfSelect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var req QdrantSearchRequest
+	if err := json.Unmarshal([]byte(jsonStr), &req); err != nil {
+		t.Fatal(err)
+	}
+	must := req.Filter.Must
+	if len(must) != 4 || must[0].Key != "meta.repo.owner" || must[1].Key != "meta.stars" || must[1].Range == nil {
+		t.Fatalf("unexpected must: %s", jsonStr)
+	}
+	if len(must[2].Must) != 2 || must[2].Must[1].Match.Value != "sql" {
+		t.Errorf("Contains() of slices should be nested must: %s", jsonStr)
+	}
+	if must[3].Key != "meta.items[].qty" || must[3].Range == nil {
+		t.Errorf("array path should be the nested key of Qdrant: %s", jsonStr)
+	}
+	if len(req.Filter.MustNot) != 1 || req.Filter.MustNot[0].IsEmpty.Key != "meta.license" {
+		t.Errorf("HasKey() should be must_not is_empty: %s", jsonStr)
+	}
+}
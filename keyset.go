@@ -0,0 +1,245 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// keysetBb builds the keyset predicate of Paged().Cursor() / After() over all sorts
+// (a DESC, b ASC) → (a < ? OR (a = ? AND b > ?)), the args of SortCase() / SortRank() are bound per use
+func (built *Built) keysetBb() *Bb {
+	after := built.PageCondition.After
+	if len(after) == 0 {
+		return nil
+	}
+	if len(built.Sorts) != len(after) {
+		if built.PageCondition.fromCursor {
+			return nil // ⭐ token of other sorts: the first page
+		}
+		panic(fmt.Sprintf("keyset: cursor has %d values, but %d sorts", len(after), len(built.Sorts)))
+	}
+
+	exprs := make([]string, len(built.Sorts))
+	exprArgs := make([][]interface{}, len(built.Sorts))
+	for i, sort := range built.Sorts {
+		exprs[i], exprArgs[i] = sort.orderBy, sort.args
+		if sort.rank {
+			exprs[i], exprArgs[i] = built.rankSql()
+		}
+		if sort.caseOf != nil {
+			exprs[i], exprArgs[i] = built.toCaseSql(sort.caseOf)
+		}
+	}
+
+	ors := make([]string, 0, len(after))
+	vs := []interface{}{}
+	for i, sort := range built.Sorts {
+		if after[i] == nil {
+			panic("keyset: cursor value of " + exprs[i] + " is nil")
+		}
+		ands := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			ands = append(ands, exprs[j]+" = ?")
+			vs = append(vs, exprArgs[j]...)
+			vs = append(vs, after[j])
+		}
+		gl := GT
+		if sort.direction == desc {
+			gl = LT
+		}
+		ands = append(ands, exprs[i]+" "+gl+" ?")
+		vs = append(vs, exprArgs[i]...)
+		vs = append(vs, after[i])
+		if len(ands) == 1 {
+			ors = append(ors, ands[0])
+		} else {
+			ors = append(ors, "("+strings.Join(ands, " AND ")+")")
+		}
+	}
+
+	key := ors[0]
+	if len(ors) > 1 {
+		key = "(" + strings.Join(ors, " OR ") + ")"
+	}
+	return &Bb{
+		Op:    XX,
+		Key:   key,
+		Value: vs,
+	}
+}
+
+// NextCursor encodes the sort values of the last row of the page, as the token of Paged().Cursor()
+// row: struct with `db` tags (or pointer), or map[string]interface{} by column
+//
+// Example:
+//
+//	if len(list) == rows {
+//	    next = built.NextCursor(list[len(list)-1])
+//	}
+func (built *Built) NextCursor(row interface{}) string {
+	values := make([]interface{}, 0, len(built.Sorts))
+	for _, sort := range built.Sorts {
+		column := sort.orderBy
+		if sort.rank {
+			column = built.rankAlias // ⭐ SortRank(): the column of SelectRank(alias)
+		}
+		v, ok := cursorValueOf(row, column)
+		if !ok {
+			panic("keyset: no value of sort " + column + " in the row")
+		}
+		values = append(values, v)
+	}
+	return EncodeCursor(values...)
+}
+
+func cursorValueOf(row interface{}, orderBy string) (interface{}, bool) {
+	column := strings.Trim(orderBy, "`\"[]")
+	short := column
+	if i := strings.LastIndex(column, "."); i >= 0 {
+		short = strings.Trim(column[i+1:], "`\"[]")
+	}
+
+	if m, ok := row.(map[string]interface{}); ok {
+		if v, ok := m[column]; ok {
+			return v, true
+		}
+		v, ok := m[short]
+		return v, ok
+	}
+
+	rv := reflect.ValueOf(row)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil, false
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, false
+	}
+	for _, f := range structFieldsOf(rv.Type()) {
+		if f.Column == column || f.Column == short {
+			return rv.FieldByIndex(f.Index).Interface(), true
+		}
+	}
+	return nil, false
+}
+
+// EncodeCursor encodes the sort values as an opaque (URL safe) cursor token
+// the types are kept: int64, uint64, float64, bool, string, time.Time; UUID and other fmt.Stringer as string
+func EncodeCursor(values ...interface{}) string {
+	items := make([]string, 0, len(values))
+	for _, v := range values {
+		items = append(items, cursorItemOf(v))
+	}
+	bs, _ := json.Marshal(items)
+	return base64.RawURLEncoding.EncodeToString(bs)
+}
+
+func cursorItemOf(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			panic("keyset: cursor value is nil")
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		panic("keyset: cursor value is nil")
+	}
+	if x, ok := rv.Interface().(time.Time); ok {
+		return "t:" + x.Format(time.RFC3339Nano)
+	}
+	// ⭐ kinds before fmt.Stringer: a named int enum is bound as its number, not its String() label
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "i:" + strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "u:" + strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return "f:" + strconv.FormatFloat(rv.Float(), 'g', -1, 64)
+	case reflect.Bool:
+		return "b:" + strconv.FormatBool(rv.Bool())
+	case reflect.String:
+		return "s:" + rv.String()
+	}
+	if x, ok := rv.Interface().(fmt.Stringer); ok {
+		return "s:" + x.String() // e.g. uuid.UUID
+	}
+	panic(fmt.Sprintf("keyset: unsupported cursor value type %T", v))
+}
+
+// ErrInvalidCursor the cursor token is not made by EncodeCursor() / NextCursor()
+var ErrInvalidCursor = errors.New("xb: invalid cursor")
+
+// DecodeCursor decodes the token of EncodeCursor(), for the tokens from clients
+//
+// Example:
+//
+//	after, err := xb.DecodeCursor(req.Cursor)
+//	if err != nil {
+//	    return 400
+//	}
+//	... Paged(func(pb *xb.PageBuilder) { pb.Rows(20).After(after...) })
+func DecodeCursor(token string) ([]interface{}, error) {
+	bs, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var items []string
+	if err := json.Unmarshal(bs, &items); err != nil || len(items) == 0 {
+		return nil, ErrInvalidCursor
+	}
+	values := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		if len(item) < 2 || item[1] != ':' {
+			return nil, ErrInvalidCursor
+		}
+		var v interface{}
+		s := item[2:]
+		switch item[0] {
+		case 'i':
+			v, err = strconv.ParseInt(s, 10, 64)
+		case 'u':
+			v, err = strconv.ParseUint(s, 10, 64)
+		case 'f':
+			v, err = strconv.ParseFloat(s, 64)
+		case 'b':
+			v, err = strconv.ParseBool(s)
+		case 't':
+			v, err = time.Parse(time.RFC3339Nano, s)
+		case 's':
+			v = s
+		default:
+			return nil, ErrInvalidCursor
+		}
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
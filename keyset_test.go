@@ -0,0 +1,248 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type feedPost struct {
+	Id        int64     `db:"id"`
+	CreatedAt time.Time `db:"created_at"`
+	Title     string    `db:"title"`
+}
+
+type postStatus int
+
+func (s postStatus) String() string {
+	return [...]string{"draft", "published"}[s]
+}
+
+func TestKeyset_FirstPage(t *testing.T) {
+	built := Of("t_post").
+		Eq("status", 1).
+		Sort("created_at", DESC).
+		Sort("id", DESC).
+		Paged(func(pb *PageBuilder) {
+			pb.Rows(20).Cursor("")
+		}).
+		Build()
+
+	countSql, dataSql, args, _ := built.SqlOfPage()
+	if countSql != "" {
+		t.Errorf("keyset should skip COUNT, got: %s", countSql)
+	}
+	want := "SELECT * FROM t_post WHERE status = ? ORDER BY created_at DESC, id DESC LIMIT 20"
+	if dataSql != want {
+		t.Errorf("got:  %s\nwant: %s", dataSql, want)
+	}
+	if !reflect.DeepEqual(args, []interface{}{1}) {
+		t.Errorf("args: %v", args)
+	}
+}
+
+func TestKeyset_MixedDirections(t *testing.T) {
+	at := time.Date(2025, 3, 1, 8, 30, 0, 123000000, time.UTC)
+	token := EncodeCursor(at, "b", int64(42))
+
+	built := Of("t_post").
+		Eq("status", 1).
+		Sort("created_at", DESC).
+		Sort("title", ASC).
+		Sort("id", DESC).
+		Paged(func(pb *PageBuilder) {
+			pb.Page(3).Rows(20).Cursor(token)
+		}).
+		Build()
+
+	countSql, dataSql, args, _ := built.SqlOfPage()
+	if countSql != "" {
+		t.Errorf("keyset should skip COUNT, got: %s", countSql)
+	}
+	want := "SELECT * FROM t_post WHERE (created_at < ? OR (created_at = ? AND title > ?) OR (created_at = ? AND title = ? AND id < ?)) AND status = ? ORDER BY created_at DESC, title ASC, id DESC LIMIT 20"
+	if dataSql != want {
+		t.Errorf("got:  %s\nwant: %s", dataSql, want)
+	}
+	wantArgs := []interface{}{at, at, "b", at, "b", int64(42), 1}
+	if !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("args: %v\nwant: %v", args, wantArgs)
+	}
+}
+
+func TestKeyset_PostgresPlaceholders(t *testing.T) {
+	built := Of("t_post").
+		Custom(NewPostgresBuilder().Build()).
+		Sort("created_at", DESC).
+		Sort("id", DESC).
+		Paged(func(pb *PageBuilder) {
+			pb.Rows(10).After("2025-03-01", 7)
+		}).
+		Build()
+
+	_, dataSql, args, _ := built.SqlOfPage()
+	if !strings.Contains(dataSql, "WHERE (created_at < $1 OR (created_at = $2 AND id < $3)) ORDER BY") {
+		t.Errorf("got: %s", dataSql)
+	}
+	if strings.Contains(dataSql, "OFFSET") {
+		t.Errorf("keyset should not use OFFSET: %s", dataSql)
+	}
+	if len(args) != 3 {
+		t.Errorf("args: %v", args)
+	}
+}
+
+func TestKeyset_NextCursorRoundTrip(t *testing.T) {
+	id := uuid.New()
+	at := time.Date(2025, 3, 1, 8, 30, 0, 123456789, time.UTC)
+
+	built := Of("t_post").
+		Sort("p.created_at", DESC).
+		Sort("id", DESC).
+		Paged(func(pb *PageBuilder) {
+			pb.Rows(2).Cursor("")
+		}).
+		Build()
+
+	token := built.NextCursor(&feedPost{Id: 9, CreatedAt: at})
+	values, err := DecodeCursor(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(values, []interface{}{at, int64(9)}) {
+		t.Errorf("values: %v", values)
+	}
+
+	token = built.NextCursor(map[string]interface{}{"created_at": "2025-03-01", "id": id})
+	values, _ = DecodeCursor(token)
+	if !reflect.DeepEqual(values, []interface{}{"2025-03-01", id.String()}) {
+		t.Errorf("values: %v", values)
+	}
+
+	values, _ = DecodeCursor(EncodeCursor(uint64(1<<63), 1.5, true))
+	if !reflect.DeepEqual(values, []interface{}{uint64(1 << 63), 1.5, true}) {
+		t.Errorf("values: %v", values)
+	}
+
+	values, _ = DecodeCursor(EncodeCursor(postStatus(1)))
+	if !reflect.DeepEqual(values, []interface{}{int64(1)}) {
+		t.Errorf("named int with String() should be its number, values: %v", values)
+	}
+}
+
+func TestKeyset_InvalidCursor(t *testing.T) {
+	for _, token := range []string{"%%%", "bm90LWpzb24", EncodeCursor() + "x"} {
+		if _, err := DecodeCursor(token); err != ErrInvalidCursor {
+			t.Errorf("%q: expected ErrInvalidCursor, got %v", token, err)
+		}
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic when cursor values don't match sorts")
+		}
+	}()
+	built := Of("t_post").
+		Sort("created_at", DESC).
+		Sort("id", DESC).
+		Paged(func(pb *PageBuilder) {
+			pb.Rows(10).After(7)
+		}).
+		Build()
+	built.SqlOfPage()
+}
+
+func TestKeyset_InvalidTokenIsFirstPage(t *testing.T) {
+	want := "SELECT * FROM t_post WHERE status = ? ORDER BY created_at DESC, id DESC LIMIT 20"
+	// malformed token, and a valid token of other sorts
+	for _, token := range []string{"%%%", EncodeCursor(int64(7))} {
+		_, dataSql, args, _ := Of("t_post").
+			Eq("status", 1).
+			Sort("created_at", DESC).
+			Sort("id", DESC).
+			Paged(func(pb *PageBuilder) {
+				pb.Rows(20).Cursor(token)
+			}).
+			Build().
+			SqlOfPage()
+		if dataSql != want || len(args) != 1 {
+			t.Errorf("%q\ngot:  %s %v\nwant: %s", token, dataSql, args, want)
+		}
+	}
+}
+
+func TestKeyset_SortCaseArgs(t *testing.T) {
+	_, dataSql, args, _ := Of("t_ticket").
+		SortCase(Case().
+			When(func(cb *CondBuilder) { cb.Eq("level", "urgent") }, 0).
+			Else(9), ASC).
+		Sort("id", DESC).
+		Paged(func(pb *PageBuilder) {
+			pb.Rows(10).After(0, int64(42))
+		}).
+		Build().
+		SqlOfPage()
+
+	want := "SELECT * FROM t_ticket WHERE (CASE WHEN level = ? THEN ? ELSE ? END > ? OR " +
+		"(CASE WHEN level = ? THEN ? ELSE ? END = ? AND id < ?)) " +
+		"ORDER BY CASE WHEN level = ? THEN ? ELSE ? END ASC, id DESC LIMIT 10"
+	if dataSql != want {
+		t.Errorf("got:  %s\nwant: %s", dataSql, want)
+	}
+	wantArgs := []interface{}{"urgent", 0, 9, 0, "urgent", 0, 9, 0, int64(42), "urgent", 0, 9}
+	if !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("args: %v", args)
+	}
+}
+
+func TestKeyset_SortRank(t *testing.T) {
+	build := func(after ...interface{}) *Built {
+		return Of("t_article").Custom(NewPostgresBuilder().Build()).
+			Select("id", "title").
+			Match([]string{"title"}, "golang", nil).
+			SelectRank("score").
+			SortRank(DESC).
+			Sort("id", DESC).
+			Paged(func(pb *PageBuilder) { pb.Rows(10).After(after...) }).
+			Build()
+	}
+
+	next := build().NextCursor(map[string]interface{}{"id": int64(9), "score": 0.5})
+	after, err := DecodeCursor(next)
+	if err != nil || !reflect.DeepEqual(after, []interface{}{0.5, int64(9)}) {
+		t.Fatalf("cursor of SortRank: %v %v", after, err)
+	}
+
+	_, dataSql, args, _ := build(after...).SqlOfPage()
+	want := "SELECT id, title, ts_rank(to_tsvector(title), websearch_to_tsquery($1)) AS score FROM t_article " +
+		"WHERE (ts_rank(to_tsvector(title), websearch_to_tsquery($2)) < $3 OR " +
+		"(ts_rank(to_tsvector(title), websearch_to_tsquery($4)) = $5 AND id < $6)) " +
+		"AND to_tsvector(title) @@ websearch_to_tsquery($7) " +
+		"ORDER BY ts_rank(to_tsvector(title), websearch_to_tsquery($8)) DESC, id DESC LIMIT 10"
+	if dataSql != want {
+		t.Errorf("got:  %s\nwant: %s", dataSql, want)
+	}
+	wantArgs := []interface{}{"golang", "golang", 0.5, "golang", 0.5, int64(9), "golang", "golang"}
+	if !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("args: %v", args)
+	}
+}
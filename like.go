@@ -0,0 +1,115 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import "strings"
+
+// LikeEscapeChar escape char of the patterns of Like() / NotLike() / ILike() / LikeLeft() / LikeRight()
+// It's the default escape char of MySQL / PostgreSQL / ClickHouse,
+// SQLite / SQL Server / Oracle Customs append ESCAPE '\'
+const LikeEscapeChar = `\`
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`, `[`, `\[`)
+
+// EscapeLike escapes \ % _ [ of user input, for LikeRaw() patterns
+// [ is a wildcard of SQL Server only, other dialects bind it as [
+//
+// Example:
+//
+//	xb.Of("t_product").LikeRaw("code", "A_"+xb.EscapeLike(input)+"%")
+func EscapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+// withLikeDialect returns a copy of built rendering LIKE by the dialect
+// escape: char of ESCAPE clause ("" without clause), nativeILike: ILIKE or LOWER(k) LIKE LOWER(?)
+func (built *Built) withLikeDialect(escape string, nativeILike bool) *Built {
+	cloned := *built
+	cloned.likeEscape = escape
+	cloned.nativeILike = nativeILike
+	return &cloned
+}
+
+func (built *Built) toLikeSql(bb Bb, bp *strings.Builder, vs *[]interface{}) {
+	if bb.Op == ILIKE && !built.nativeILike {
+		bp.WriteString("LOWER(")
+		bp.WriteString(bb.Key)
+		bp.WriteString(") LIKE LOWER(?)")
+	} else {
+		bp.WriteString(bb.Key)
+		bp.WriteString(" ")
+		bp.WriteString(bb.Op)
+		bp.WriteString(" ?")
+	}
+	if built.likeEscape != "" {
+		bp.WriteString(" ESCAPE '")
+		bp.WriteString(built.likeEscape)
+		bp.WriteString("'")
+	}
+	if vs != nil {
+		v := bb.Value
+		if s, ok := v.(string); ok && built.dialect != dialectSQLServer {
+			v = unescapeLikeBracket(s)
+		}
+		*vs = append(*vs, v)
+	}
+}
+
+// unescapeLikeBracket \[ => [, for dialects which [ isn't a wildcard of (Oracle rejects \[)
+func unescapeLikeBracket(pattern string) string {
+	if !strings.Contains(pattern, `\[`) {
+		return pattern
+	}
+	sb := strings.Builder{}
+	last := len(pattern) - 1
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		if c == '\\' && i < last {
+			i++
+			if pattern[i] != '[' {
+				sb.WriteByte(c)
+			}
+		}
+		sb.WriteByte(pattern[i])
+	}
+	return sb.String()
+}
+
+// likeText the text of %text% / text% / %text patterns (unescaped) for full-text match of vector DBs
+// false if the pattern has other wildcards
+func likeText(pattern string) (string, bool) {
+	sb := strings.Builder{}
+	last := len(pattern) - 1
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case c == '\\' && i < last:
+			i++
+			sb.WriteByte(pattern[i])
+		case c == '%' && (i == 0 || i == last):
+		case c == '%' || c == '_':
+			return "", false
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	if sb.Len() == 0 {
+		return "", false
+	}
+	return sb.String(), true
+}
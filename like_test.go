@@ -0,0 +1,134 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLike_EscapesWildcards(t *testing.T) {
+	built := Of("t_product").
+		Like("name", `50%_off\`).
+		LikeLeft("code", "A_").
+		LikeRight("email", "@x.com").
+		LikeRaw("sku", "A_%").
+		NotLike("remark", "test").
+		Build()
+
+	sql, args, _ := built.SqlOfSelect()
+	want := "SELECT * FROM t_product WHERE name LIKE ? AND code LIKE ? AND email LIKE ? AND sku LIKE ? AND remark NOT LIKE ?"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+	wantArgs := []interface{}{`%50\%\_off\\%`, `A\_%`, "%@x.com", "A_%", "%test%"}
+	if !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("args: %v\nwant: %v", args, wantArgs)
+	}
+}
+
+func TestLike_DialectEscapeAndILike(t *testing.T) {
+	build := func(c Custom) string {
+		sql, _, _ := Of("t_user").Custom(c).ILike("name", "tom").Like("nick", "5%").Build().SqlOfSelect()
+		return sql
+	}
+
+	cases := []struct {
+		name   string
+		custom Custom
+		want   string
+	}{
+		{"MySQL", NewMySQLBuilder().Build(),
+			"SELECT * FROM t_user WHERE LOWER(name) LIKE LOWER(?) AND nick LIKE ?"},
+		{"PostgreSQL", NewPostgresBuilder().Build(),
+			"SELECT * FROM t_user WHERE name ILIKE $1 AND nick LIKE $2"},
+		{"SQLite", NewSQLiteBuilder().Build(),
+			`SELECT * FROM t_user WHERE LOWER(name) LIKE LOWER(?) ESCAPE '\' AND nick LIKE ? ESCAPE '\'`},
+		{"SQLServer", NewSQLServerBuilder().Build(),
+			`SELECT * FROM [t_user] WHERE LOWER([name]) LIKE LOWER(@p1) ESCAPE '\' AND [nick] LIKE @p2 ESCAPE '\'`},
+		{"ClickHouse", NewClickHouseBuilder().Build(),
+			"SELECT * FROM t_user WHERE name ILIKE ? AND nick LIKE ?"},
+	}
+	for _, c := range cases {
+		if got := build(c.custom); got != c.want {
+			t.Errorf("%s\ngot:  %s\nwant: %s", c.name, got, c.want)
+		}
+	}
+}
+
+func TestLike_SQLServerEscapesBracket(t *testing.T) {
+	build := func(c Custom) []interface{} {
+		_, args, _ := Of("t_product").Custom(c).Like("name", "[A]50%").LikeRaw("code", "[a-c]_").Build().SqlOfSelect()
+		return args
+	}
+
+	if args := build(NewSQLServerBuilder().Build()); !reflect.DeepEqual(args, []interface{}{`%\[A]50\%%`, "[a-c]_"}) {
+		t.Errorf("SQLServer args: %v", args)
+	}
+	for _, c := range []Custom{NewMySQLBuilder().Build(), NewOracleBuilder().Build(), NewPostgresBuilder().Build()} {
+		if args := build(c); !reflect.DeepEqual(args, []interface{}{`%[A]50\%%`, "[a-c]_"}) {
+			t.Errorf("%T args: %v", c, args)
+		}
+	}
+	if args := build(nil); !reflect.DeepEqual(args, []interface{}{`%[A]50\%%`, "[a-c]_"}) {
+		t.Errorf("default args: %v", args)
+	}
+}
+
+func TestLike_SubqueryKeepsDialect(t *testing.T) {
+	sql, _, _ := Of("t_user u").
+		Custom(NewSQLiteBuilder().Build()).
+		Exists(func(sb *BuilderX) {
+			sb.From("t_tag g").Select("1").X("g.user_id = u.id").Like("g.name", "a_b")
+		}).
+		Build().
+		SqlOfSelect()
+	if !strings.Contains(sql, `g.name LIKE ? ESCAPE '\')`) {
+		t.Errorf("unexpected: %s", sql)
+	}
+}
+
+func TestLike_QdrantMatchText(t *testing.T) {
+	built := Of(&CodeVector{}).
+		Custom(NewQdrantBuilder().Build()).
+		VectorSearch("embedding", Vector{0.1, 0.2, 0.3}, 10).
+		Like("content", "50% off").
+		NotLike("content", "draft").
+		LikeRaw("path", "src/%/main.go").
+		Build()
+
+	jsonStr, err := built.JsonOfSelect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var req QdrantSearchRequest
+	if err := json.Unmarshal([]byte(jsonStr), &req); err != nil {
+		t.Fatal(err)
+	}
+	if len(req.Filter.Must) != 1 || req.Filter.Must[0].Match.Text != "50% off" {
+		t.Errorf("LIKE should be match.text in must: %s", jsonStr)
+	}
+	if len(req.Filter.MustNot) != 1 || req.Filter.MustNot[0].Match.Text != "draft" {
+		t.Errorf("NOT LIKE should be match.text in must_not: %s", jsonStr)
+	}
+	if strings.Contains(jsonStr, "src/") {
+		t.Errorf("LikeRaw with inner wildcards should be ignored: %s", jsonStr)
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"regexp"
+	"strings"
+
+	. "github.com/fndome/xb/internal"
+)
+
+// LockMode row lock of Lock()
+type LockMode func() string
+
+// ForUpdate FOR UPDATE (SQL Server: UPDLOCK, ROWLOCK)
+func ForUpdate() string {
+	return "FOR UPDATE"
+}
+
+// ForNoKeyUpdate FOR NO KEY UPDATE (PostgreSQL)
+func ForNoKeyUpdate() string {
+	return "FOR NO KEY UPDATE"
+}
+
+// ForShare FOR SHARE (SQL Server: HOLDLOCK, ROWLOCK)
+func ForShare() string {
+	return "FOR SHARE"
+}
+
+// ForKeyShare FOR KEY SHARE (PostgreSQL)
+func ForKeyShare() string {
+	return "FOR KEY SHARE"
+}
+
+type lockClause struct {
+	mode string
+	of   []string
+	wait string // SKIP LOCKED / NOWAIT
+}
+
+// LockOption option of Lock()
+type LockOption func(lc *lockClause)
+
+// SkipLocked SKIP LOCKED, rows locked by others are skipped (SQL Server: READPAST)
+func SkipLocked() LockOption {
+	return func(lc *lockClause) {
+		lc.wait = "SKIP LOCKED"
+	}
+}
+
+// NoWait NOWAIT, fails at once if a row is locked by others
+func NoWait() LockOption {
+	return func(lc *lockClause) {
+		lc.wait = "NOWAIT"
+	}
+}
+
+// LockOf OF alias, only the rows of the tables are locked (joins)
+// SQL Server: the table hints are added to the tables (default: the first table)
+func LockOf(aliases ...string) LockOption {
+	return func(lc *lockClause) {
+		lc.of = append(lc.of, aliases...)
+	}
+}
+
+// Lock row locks of SELECT, rendered by the dialect of Custom:
+//   - PostgreSQL / MySQL (default): ... LIMIT 10 FOR UPDATE OF j SKIP LOCKED
+//   - Oracle:     FOR UPDATE (without pagination)
+//   - SQL Server: FROM t_job WITH (UPDLOCK, ROWLOCK, READPAST)
+//
+// DialectError with UNION / GROUP BY / HAVING / DISTINCT / aggregates / window functions,
+// or the dialect has no such lock (SQLite / ClickHouse have no row locks)
+//
+// Example:
+//
+//	xb.Of("t_job").
+//	    Eq("status", "ready").
+//	    Sort("id", xb.ASC).
+//	    Limit(10).
+//	    Lock(xb.ForUpdate, xb.SkipLocked()).
+//	    Build()
+//	// SELECT * FROM t_job WHERE status = ? ORDER BY id ASC LIMIT 10 FOR UPDATE SKIP LOCKED
+func (x *BuilderX) Lock(mode LockMode, opts ...LockOption) *BuilderX {
+	if mode == nil {
+		mode = ForUpdate
+	}
+	lc := &lockClause{mode: mode()}
+	for _, opt := range opts {
+		opt(lc)
+	}
+	x.lock = lc
+	return x
+}
+
+func (built *Built) toLockSql(bp *strings.Builder) {
+	if built.lock == nil || built.lockHint {
+		return
+	}
+	bp.WriteString(SPACE)
+	bp.WriteString(built.lock.mode)
+	if len(built.lock.of) > 0 {
+		bp.WriteString(" OF ")
+		bp.WriteString(strings.Join(built.lock.of, COMMA))
+	}
+	if built.lock.wait != "" {
+		bp.WriteString(SPACE)
+		bp.WriteString(built.lock.wait)
+	}
+}
+
+var aggFuncRegex = regexp.MustCompile(`(?i)\b(COUNT|SUM|AVG|MIN|MAX)\s*\(`)
+
+// lockError DialectError of Lock(): UNION / aggregates, or the mode is not in supported (empty: any mode)
+func (built *Built) lockError(dialect string, supported ...LockMode) error {
+	if built.lock == nil || built.Inserts != nil || built.Updates != nil || built.Delete {
+		return nil
+	}
+	if len(built.Unions) > 0 {
+		return &DialectError{Dialect: dialect, Reason: "Lock() can not be used with UNION"}
+	}
+	aggregated := len(built.GroupBys) > 0 || len(built.Havings) > 0 || len(built.Aggs) > 0 || len(built.Windows) > 0
+	for _, k := range built.ResultKeys {
+		if strings.HasPrefix(strings.ToUpper(strings.TrimSpace(k)), "DISTINCT") || aggFuncRegex.MatchString(k) {
+			aggregated = true
+		}
+	}
+	if aggregated {
+		return &DialectError{Dialect: dialect, Reason: "Lock() can not be used with GROUP BY / HAVING / DISTINCT / aggregates"}
+	}
+	if len(supported) == 0 {
+		return nil
+	}
+	for _, mode := range supported {
+		if mode() == built.lock.mode {
+			return nil
+		}
+	}
+	return &DialectError{Dialect: dialect, Reason: "Lock(), " + built.lock.mode + " is not supported"}
+}
+
+// noLockError DialectError of the dialects without row locks
+func (built *Built) noLockError(dialect string) error {
+	if built.lock == nil {
+		return nil
+	}
+	return &DialectError{Dialect: dialect, Reason: "Lock() row locks are not supported"}
+}
+
+// withLockHint returns a copy of built rendering Lock() as table hints (SQL Server)
+func (built *Built) withLockHint() *Built {
+	cloned := *built
+	cloned.lockHint = true
+	return &cloned
+}
+
+// toLockHintSql writes WITH (UPDLOCK, ROWLOCK, READPAST) after the table of LockOf() (default: the first table)
+func (built *Built) toLockHintSql(bp *strings.Builder, table string, alias string, first bool) {
+	if built.lock == nil || !built.lockHint {
+		return
+	}
+	if len(built.lock.of) == 0 {
+		if !first {
+			return
+		}
+	} else if !built.isLockOf(table, alias) {
+		return
+	}
+	hints := []string{"UPDLOCK", "ROWLOCK"}
+	if built.lock.mode == ForShare() {
+		hints = []string{"HOLDLOCK", "ROWLOCK"}
+	}
+	switch built.lock.wait {
+	case "SKIP LOCKED":
+		hints = append(hints, "READPAST")
+	case "NOWAIT":
+		hints = append(hints, "NOWAIT")
+	}
+	bp.WriteString(" WITH (")
+	bp.WriteString(strings.Join(hints, COMMA))
+	bp.WriteString(")")
+}
+
+// isLockOf the table is in LockOf(), compared without the quotes of QuoteIdentifiers()
+func (built *Built) isLockOf(table string, alias string) bool {
+	table = unquoteIdent(table)
+	alias = unquoteIdent(alias)
+	for _, of := range built.lock.of {
+		of = unquoteIdent(of)
+		if of == table || (alias != "" && of == alias) {
+			return true
+		}
+	}
+	return false
+}
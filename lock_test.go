@@ -0,0 +1,205 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLock_Default(t *testing.T) {
+	sql, args, _ := Of("t_job").
+		Eq("status", "ready").
+		Sort("id", ASC).
+		Limit(10).
+		Lock(ForUpdate, SkipLocked()).
+		Build().
+		SqlOfSelect()
+
+	want := "SELECT * FROM t_job WHERE status = ? ORDER BY id ASC LIMIT 10 FOR UPDATE SKIP LOCKED"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+	if len(args) != 1 {
+		t.Errorf("args: %v", args)
+	}
+}
+
+func TestLock_MySQL(t *testing.T) {
+	sql, _, _ := Of("t_job").
+		Custom(NewMySQLBuilder().Build()).
+		Eq("status", "ready").
+		Sort("id", ASC).
+		Limit(10).
+		Lock(ForShare, NoWait()).
+		Build().
+		SqlOfSelect()
+
+	want := "SELECT * FROM t_job WHERE status = ? ORDER BY id ASC LIMIT 10 FOR SHARE NOWAIT"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+}
+
+func TestLock_PostgreSQL(t *testing.T) {
+	sql, _, _ := Of("t_job").
+		Custom(NewPostgresBuilder().Build()).
+		Eq("status", "ready").
+		Sort("id", ASC).
+		Limit(10).
+		Lock(ForUpdate, SkipLocked()).
+		Build().
+		SqlOfSelect()
+
+	want := "SELECT * FROM t_job WHERE status = $1 ORDER BY id ASC LIMIT 10 FOR UPDATE SKIP LOCKED"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+}
+
+func TestLock_PostgreSQLWithoutConditions(t *testing.T) {
+	sql, args, _ := Of("t_job").
+		Custom(NewPostgresBuilder().Build()).
+		Limit(1).
+		Lock(ForKeyShare).
+		Build().
+		SqlOfSelect()
+
+	if sql != "SELECT * FROM t_job LIMIT 1 FOR KEY SHARE" {
+		t.Errorf("unexpected SQL: %s", sql)
+	}
+	if len(args) != 0 {
+		t.Errorf("args: %v", args)
+	}
+}
+
+func TestLock_SQLServer(t *testing.T) {
+	sql, _, _ := Of("t_job").
+		Custom(NewSQLServerBuilder().Build()).
+		Eq("status", "ready").
+		Sort("id", ASC).
+		Limit(10).
+		Lock(ForUpdate, SkipLocked()).
+		Build().
+		SqlOfSelect()
+
+	want := "SELECT TOP 10 * FROM [t_job] WITH (UPDLOCK, ROWLOCK, READPAST) WHERE [status] = @p1 ORDER BY [id] ASC"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+}
+
+func TestLock_SQLServerWithoutConditions(t *testing.T) {
+	sql, _, _ := Of("t_job").
+		Custom(NewSQLServerBuilder().Build()).
+		Sort("id", ASC).
+		Limit(1).
+		Lock(ForShare).
+		Build().
+		SqlOfSelect()
+
+	if sql != "SELECT TOP 1 * FROM [t_job] WITH (HOLDLOCK, ROWLOCK) ORDER BY [id] ASC" {
+		t.Errorf("unexpected SQL: %s", sql)
+	}
+}
+
+func TestLock_PagedAndOf(t *testing.T) {
+	built := Of("t_job").As("j").
+		Custom(NewPostgresBuilder().Build()).
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(INNER).Of("t_queue").As("q").On("q.id = j.queue_id")
+		}).
+		Eq("q.name", "mail").
+		Sort("j.id", ASC).
+		Paged(func(pb *PageBuilder) {
+			pb.Page(1).Rows(20)
+		}).
+		Lock(ForNoKeyUpdate, LockOf("j"), NoWait()).
+		Build()
+
+	countSql, dataSql, _, _ := built.SqlOfPage()
+	want := "SELECT * FROM t_job j INNER JOIN t_queue q ON q.id = j.queue_id WHERE q.name = $1 ORDER BY j.id ASC LIMIT 20 OFFSET 0 FOR NO KEY UPDATE OF j NOWAIT"
+	if dataSql != want {
+		t.Errorf("got:  %s\nwant: %s", dataSql, want)
+	}
+	if countSql == "" || strings.Contains(countSql, "NOWAIT") {
+		t.Errorf("COUNT should not lock: %s", countSql)
+	}
+}
+
+func TestLock_SQLServerHintOfAlias(t *testing.T) {
+	sql, _, _ := Of("t_job").As("j").
+		Custom(NewSQLServerBuilder().Build()).
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(INNER).Of("t_queue").As("q").On("q.id = j.queue_id")
+		}).
+		Lock(ForUpdate, LockOf("q"), NoWait()).
+		Build().
+		SqlOfSelect()
+	want := "SELECT * FROM [t_job] [j] INNER JOIN [t_queue] [q] WITH (UPDLOCK, ROWLOCK, NOWAIT) ON q.id = j.queue_id"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+}
+
+func TestLock_SQLServerHintOfQuotedTable(t *testing.T) {
+	sql, _, _ := Of("t_job").
+		Custom(DefaultSQLServerCustom()).
+		Eq("status", "ready").
+		Lock(ForUpdate, LockOf("t_job")).
+		Build().
+		SqlOfSelect()
+	want := "SELECT * FROM [t_job] WITH (UPDLOCK, ROWLOCK) WHERE [status] = @p1"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+}
+
+func TestLock_Rejected(t *testing.T) {
+	cases := []struct {
+		name   string
+		custom Custom
+		x      *BuilderX
+	}{
+		{"UNION", NewPostgresBuilder().Build(),
+			Of("t_job").Lock(ForUpdate).UNION(ALL, func(sb *BuilderX) { sb.From("t_job_old") })},
+		{"GROUP BY", NewPostgresBuilder().Build(),
+			Of("t_job").Select("status", "COUNT(*) AS n").GroupBy("status").Lock(ForUpdate)},
+		{"aggregate", NewMySQLBuilder().Build(),
+			Of("t_job").Select("MAX(id) AS m").Lock(ForUpdate)},
+		{"MySQL KEY SHARE", NewMySQLBuilder().Build(),
+			Of("t_job").Lock(ForKeyShare)},
+		{"SQLite", NewSQLiteBuilder().Build(),
+			Of("t_job").Lock(ForUpdate)},
+		{"Oracle paged", NewOracleBuilder().Build(),
+			Of("t_job").Limit(10).Lock(ForUpdate)},
+	}
+	for _, c := range cases {
+		_, err := c.custom.Generate(c.x.Build())
+		if _, ok := err.(*DialectError); !ok {
+			t.Errorf("%s: want DialectError, got %v", c.name, err)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("default SQL with UNION should panic")
+		}
+	}()
+	Of("t_job").Lock(ForUpdate).UNION(ALL, func(sb *BuilderX) { sb.From("t_job_old") }).Build().SqlOfSelect()
+}
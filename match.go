@@ -0,0 +1,268 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"strings"
+
+	. "github.com/fndome/xb/internal"
+)
+
+// MatchMode search mode of Match()
+type MatchMode func() string
+
+// NaturalLanguageMode plain words, the default mode of Match()
+// MySQL: IN NATURAL LANGUAGE MODE, SQLite: each word is quoted as a FTS5 phrase
+func NaturalLanguageMode() string {
+	return "IN NATURAL LANGUAGE MODE"
+}
+
+// BooleanMode operators of the query are kept (MySQL +word -word "phrase", FTS5 AND / OR / NOT ...)
+func BooleanMode() string {
+	return "IN BOOLEAN MODE"
+}
+
+// QueryExpansionMode MySQL WITH QUERY EXPANSION, natural language for other dialects
+func QueryExpansionMode() string {
+	return "WITH QUERY EXPANSION"
+}
+
+type fullTextMatch struct {
+	cols  []string
+	query string
+	mode  string
+}
+
+// Match full-text condition of cols, skipped if the query is blank
+// Rendered by the dialect of Custom:
+//   - MySQL (default):  MATCH(title, body) AGAINST(? IN BOOLEAN MODE)
+//   - PostgreSQL:       to_tsvector(...) @@ websearch_to_tsquery(?), multiple cols are joined by ' ' (coalesced)
+//   - SQLite (FTS5):    body MATCH ?, or t_doc MATCH ? with the column filter {title body} : (...)
+//   - Qdrant:           match.text of the payload (should of the cols)
+//
+// Oracle / SQL Server / ClickHouse Customs return DialectError
+//
+// Example:
+//
+//	xb.Of("t_article").
+//	    Match([]string{"title", "body"}, "golang sql builder", xb.BooleanMode).
+//	    Eq("status", 1).
+//	    Build()
+func (cb *CondBuilder) Match(cols []string, query string, mode MatchMode) *CondBuilder {
+	if len(cols) == 0 {
+		panic("Match(cols, query, mode), cols can not be empty")
+	}
+	if strings.TrimSpace(query) == "" {
+		return cb
+	}
+	if mode == nil {
+		mode = NaturalLanguageMode
+	}
+	cb.bbs = append(cb.bbs, Bb{
+		Op:    MATCH,
+		Key:   strings.Join(cols, ", "),
+		Value: fullTextMatch{cols: cols, query: query, mode: mode()},
+	})
+	return cb
+}
+
+// Match full-text condition, see CondBuilder.Match()
+func (x *BuilderX) Match(cols []string, query string, mode MatchMode) *BuilderX {
+	x.CondBuilder.Match(cols, query, mode)
+	return x
+}
+
+// SelectRank selects the relevance of the first Match() AS alias, higher is more relevant
+// Skipped if Match() was skipped, call it after Match()
+//   - MySQL:      MATCH(...) AGAINST(...)
+//   - PostgreSQL: ts_rank(to_tsvector(...), websearch_to_tsquery(?))
+//   - SQLite:     -bm25(t_doc)
+//
+// Example:
+//
+//	xb.Of("t_article").
+//	    Match([]string{"title", "body"}, q, nil).
+//	    SelectRank("score").
+//	    SortRank(xb.DESC).
+//	    Build()
+func (x *BuilderX) SelectRank(alias string) *BuilderX {
+	if alias == "" {
+		panic("SelectRank(alias), alias required")
+	}
+	if findMatchBb(x.bbs) != nil {
+		x.rankAlias = alias
+	}
+	return x
+}
+
+// SortRank sorts by the relevance of the first Match(), skipped if Match() was skipped
+func (x *BuilderX) SortRank(direction Direction) *BuilderX {
+	if findMatchBb(x.bbs) == nil {
+		return x
+	}
+	sort := Sort{rank: true}
+	if direction != nil {
+		sort.direction = direction()
+	}
+	x.sorts = append(x.sorts, sort)
+	return x
+}
+
+func findMatchBb(bbs []Bb) *Bb {
+	for i := range bbs {
+		if bbs[i].Op == MATCH {
+			return &bbs[i]
+		}
+	}
+	return nil
+}
+
+func containsMatch(bbs []Bb) bool {
+	for _, bb := range bbs {
+		if bb.Op == MATCH || containsMatch(bb.Subs) {
+			return true
+		}
+	}
+	return false
+}
+
+// withTsConfig returns a copy of built with the text search config of PostgreSQL Match(),
+// e.g. english ("" for default_text_search_config)
+func (built *Built) withTsConfig(tsConfig string) *Built {
+	cloned := *built
+	cloned.tsConfig = tsConfig
+	return &cloned
+}
+
+// fullTextError DialectError of the dialects without Match()
+func (built *Built) fullTextError(dialect string) error {
+	if containsMatch(built.Conds) || containsMatch(built.Havings) {
+		return &DialectError{Dialect: dialect, Reason: "Match() full-text search is not supported"}
+	}
+	return nil
+}
+
+func (built *Built) toMatchSql(bb Bb, bp *strings.Builder, vs *[]interface{}) {
+	m := bb.Value.(fullTextMatch)
+	var arg interface{}
+	switch built.dialect {
+	case dialectPostgres:
+		bp.WriteString(built.tsVector(m.cols))
+		bp.WriteString(" @@ ")
+		bp.WriteString(built.tsQuery())
+		arg = m.query
+	case dialectSQLite:
+		query := m.query
+		if m.mode != BooleanMode() {
+			query = fts5Phrases(query)
+		}
+		if len(m.cols) == 1 {
+			bp.WriteString(m.cols[0])
+		} else {
+			bp.WriteString(built.ftsTable())
+			query = "{" + strings.Join(m.cols, " ") + "} : (" + query + ")"
+		}
+		bp.WriteString(" MATCH ?")
+		arg = query
+	default:
+		bp.WriteString(mysqlMatch(m))
+		arg = m.query
+	}
+	if vs != nil {
+		*vs = append(*vs, arg)
+	}
+}
+
+// rankSql relevance expression of the first Match() (SelectRank() / SortRank())
+func (built *Built) rankSql() (string, []interface{}) {
+	bb := findMatchBb(built.Conds)
+	if bb == nil {
+		return "", nil
+	}
+	m := bb.Value.(fullTextMatch)
+	switch built.dialect {
+	case dialectPostgres:
+		return "ts_rank(" + built.tsVector(m.cols) + COMMA + built.tsQuery() + ")", []interface{}{m.query}
+	case dialectSQLite:
+		return "-bm25(" + built.ftsTable() + ")", nil
+	default:
+		return mysqlMatch(m), []interface{}{m.query}
+	}
+}
+
+func mysqlMatch(m fullTextMatch) string {
+	return "MATCH(" + strings.Join(m.cols, ", ") + ") AGAINST(? " + m.mode + ")"
+}
+
+func (built *Built) tsVector(cols []string) string {
+	doc := cols[0]
+	if len(cols) > 1 {
+		parts := make([]string, len(cols))
+		for i, col := range cols {
+			parts[i] = "coalesce(" + col + ", '')"
+		}
+		doc = strings.Join(parts, " || ' ' || ")
+	}
+	if built.tsConfig != "" {
+		return "to_tsvector('" + built.tsConfig + "', " + doc + ")"
+	}
+	return "to_tsvector(" + doc + ")"
+}
+
+func (built *Built) tsQuery() string {
+	if built.tsConfig != "" {
+		return "websearch_to_tsquery('" + built.tsConfig + "', ?)"
+	}
+	return "websearch_to_tsquery(?)"
+}
+
+// ftsTable the FTS5 table (or its alias), its hidden column matches all cols
+func (built *Built) ftsTable() string {
+	if fields := strings.Fields(built.OrFromSql); len(fields) > 0 {
+		if strings.EqualFold(fields[0], "FROM") {
+			fields = fields[1:]
+		}
+		if len(fields) > 2 && strings.EqualFold(fields[1], "AS") {
+			return fields[2]
+		}
+		if len(fields) > 1 {
+			return fields[1]
+		}
+		if len(fields) == 1 {
+			return fields[0]
+		}
+	}
+	for _, fx := range built.Fxs {
+		if fx.alia != "" {
+			return fx.alia
+		}
+		if fx.tableName != "" {
+			return fx.tableName
+		}
+	}
+	panic("Match() of multiple cols on SQLite requires the FTS5 table")
+}
+
+// fts5Phrases quotes each word as a FTS5 phrase, operators and punctuation lose their meaning
+func fts5Phrases(query string) string {
+	words := strings.Fields(query)
+	for i, w := range words {
+		words[i] = `"` + strings.ReplaceAll(w, `"`, `""`) + `"`
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,193 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMatch_Dialects(t *testing.T) {
+	build := func(c Custom) (string, []interface{}) {
+		sql, args, _ := Of("t_article").Custom(c).
+			Match([]string{"title", "body"}, "go sql", BooleanMode).
+			Eq("status", 1).
+			Build().
+			SqlOfSelect()
+		return sql, args
+	}
+
+	cases := []struct {
+		name   string
+		custom Custom
+		want   string
+		arg    string
+	}{
+		{"MySQL", NewMySQLBuilder().Build(),
+			"SELECT * FROM t_article WHERE MATCH(title, body) AGAINST(? IN BOOLEAN MODE) AND status = ?", "go sql"},
+		{"PostgreSQL", NewPostgresBuilder().Build(),
+			"SELECT * FROM t_article WHERE to_tsvector(coalesce(title, '') || ' ' || coalesce(body, '')) @@ websearch_to_tsquery($1) AND status = $2", "go sql"},
+		{"PostgreSQL config", NewPostgresBuilder().TextSearchConfig("english").Build(),
+			"SELECT * FROM t_article WHERE to_tsvector('english', coalesce(title, '') || ' ' || coalesce(body, '')) @@ websearch_to_tsquery('english', $1) AND status = $2", "go sql"},
+		{"SQLite", NewSQLiteBuilder().Build(),
+			"SELECT * FROM t_article WHERE t_article MATCH ? AND status = ?", "{title body} : (go sql)"},
+	}
+	for _, c := range cases {
+		sql, args := build(c.custom)
+		if sql != c.want {
+			t.Errorf("%s\ngot:  %s\nwant: %s", c.name, sql, c.want)
+		}
+		if len(args) != 2 || args[0] != c.arg {
+			t.Errorf("%s args: %v", c.name, args)
+		}
+	}
+}
+
+func TestMatch_SQLiteNaturalLanguageQuotesWords(t *testing.T) {
+	sql, args, _ := Of("t_doc_fts").Custom(NewSQLiteBuilder().Build()).
+		Match([]string{"body"}, `c++ "tips"`, nil).
+		Build().
+		SqlOfSelect()
+	if sql != "SELECT * FROM t_doc_fts WHERE body MATCH ?" {
+		t.Errorf("unexpected: %s", sql)
+	}
+	if args[0] != `"c++" """tips"""` {
+		t.Errorf("unexpected arg: %v", args[0])
+	}
+}
+
+func TestMatch_SQLiteUsesTableAlias(t *testing.T) {
+	for _, x := range []*BuilderX{Of("docs d"), Of("docs").As("d")} {
+		sql, _, _ := x.Custom(NewSQLiteBuilder().Build()).
+			Select("id").
+			Match([]string{"title", "body"}, "golang", nil).
+			SelectRank("score").
+			Build().
+			SqlOfSelect()
+		want := "SELECT id, -bm25(d) AS score FROM docs d WHERE d MATCH ?"
+		if sql != want {
+			t.Errorf("got:  %s\nwant: %s", sql, want)
+		}
+	}
+}
+
+func TestMatch_SkipsBlankQuery(t *testing.T) {
+	sql, _, _ := Of("t_article").
+		Match([]string{"title"}, "  ", nil).
+		SelectRank("score").
+		SortRank(DESC).
+		Build().
+		SqlOfSelect()
+	if sql != "SELECT * FROM t_article" {
+		t.Errorf("unexpected: %s", sql)
+	}
+}
+
+func TestMatch_RankSelectAndSort(t *testing.T) {
+	build := func(c Custom) (string, []interface{}) {
+		sql, args, _ := Of("t_article").Custom(c).
+			Select("id", "title").
+			Match([]string{"title"}, "golang", nil).
+			SelectRank("score").
+			SortRank(DESC).
+			Build().
+			SqlOfSelect()
+		return sql, args
+	}
+
+	sql, args := build(NewPostgresBuilder().Build())
+	want := "SELECT id, title, ts_rank(to_tsvector(title), websearch_to_tsquery($1)) AS score FROM t_article " +
+		"WHERE to_tsvector(title) @@ websearch_to_tsquery($2) ORDER BY ts_rank(to_tsvector(title), websearch_to_tsquery($3)) DESC"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+	if !reflect.DeepEqual(args, []interface{}{"golang", "golang", "golang"}) {
+		t.Errorf("args: %v", args)
+	}
+
+	sql, args = build(NewMySQLBuilder().Build())
+	if !strings.HasPrefix(sql, "SELECT id, title, MATCH(title) AGAINST(? IN NATURAL LANGUAGE MODE) AS score FROM") || len(args) != 3 {
+		t.Errorf("unexpected: %s %v", sql, args)
+	}
+
+	sql, args = build(NewSQLiteBuilder().Build())
+	if !strings.Contains(sql, "-bm25(t_article) AS score") || !strings.HasSuffix(sql, "ORDER BY -bm25(t_article) DESC") || len(args) != 1 {
+		t.Errorf("unexpected: %s %v", sql, args)
+	}
+}
+
+func TestMatch_RankWithoutSelectKeys(t *testing.T) {
+	sql, _, _ := Of("t_article").
+		Match([]string{"title"}, "golang", nil).
+		SelectRank("score").
+		Build().
+		SqlOfSelect()
+	want := "SELECT *, MATCH(title) AGAINST(? IN NATURAL LANGUAGE MODE) AS score FROM t_article WHERE MATCH(title) AGAINST(? IN NATURAL LANGUAGE MODE)"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+}
+
+func TestMatch_UnsupportedDialect(t *testing.T) {
+	built := Of("t_article").Match([]string{"title"}, "golang", nil).Build()
+	for _, c := range []Custom{NewOracleBuilder().Build(), NewSQLServerBuilder().Build(), NewClickHouseBuilder().Build()} {
+		if _, err := c.Generate(built); err == nil {
+			t.Errorf("%T should return DialectError", c)
+		} else if _, ok := err.(*DialectError); !ok {
+			t.Errorf("%T: %v", c, err)
+		}
+	}
+}
+
+func TestMatch_HybridWithVectorSearch(t *testing.T) {
+	built := Of(&CodeVector{}).
+		Custom(NewQdrantBuilder().Build()).
+		VectorSearch("embedding", Vector{0.1, 0.2, 0.3}, 10).
+		Match([]string{"content"}, "binary search", nil).
+		Match([]string{"title", "summary"}, "golang", nil).
+		Build()
+
+	jsonStr, err := built.JsonOfSelect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var req QdrantSearchRequest
+	if err := json.Unmarshal([]byte(jsonStr), &req); err != nil {
+		t.Fatal(err)
+	}
+	if len(req.Filter.Must) != 2 {
+		t.Fatalf("unexpected filter: %s", jsonStr)
+	}
+	if c := req.Filter.Must[0]; c.Key != "content" || c.Match.Text != "binary search" {
+		t.Errorf("single col should be match.text: %s", jsonStr)
+	}
+	if c := req.Filter.Must[1]; len(c.Should) != 2 || c.Should[1].Key != "summary" || c.Should[1].Match.Text != "golang" {
+		t.Errorf("multiple cols should be nested should: %s", jsonStr)
+	}
+
+	sql, args := Of(&CodeVector{}).
+		VectorSearch("embedding", Vector{0.1, 0.2, 0.3}, 10).
+		Match([]string{"content"}, "binary search", nil).
+		Build().
+		SqlOfVectorSearch()
+	if !strings.Contains(sql, "WHERE to_tsvector(content) @@ websearch_to_tsquery(?) ORDER BY distance") || len(args) != 2 {
+		t.Errorf("unexpected: %s %v", sql, args)
+	}
+}
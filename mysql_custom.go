@@ -76,12 +76,13 @@ func (mb *MySQLBuilder) Build() *MySQLCustom {
 //
 // Notes:
 //   - xb defaults to MySQL-compatible SQL syntax (? placeholder, LIMIT/OFFSET)
-//   - PostgreSQL/SQLite drivers automatically convert placeholders (? → $1, $2)
+//   - PostgreSQL: use PostgresCustom, placeholders are numbered (? → $1, $2)
 //   - Most scenarios don't need Custom, use default implementation directly
 //
 // Use cases:
 //   - MySQL special syntax (ON DUPLICATE KEY UPDATE, INSERT IGNORE)
-//   - Performance optimization (STRAIGHT_JOIN, FORCE INDEX)
+//   - Performance optimization: JOIN(xb.STRAIGHT), UseIndex() / ForceIndex() / IgnoreIndex(), OptimizerHint()
+//     (the hints are ignored by the other Customs)
 //   - Extended functionality (user-defined)
 //
 // Example:
@@ -148,6 +149,14 @@ func newMySQLCustom() *MySQLCustom {
 //   - interface{}: *SQLResult
 //   - error: error information
 func (c *MySQLCustom) Generate(built *Built) (interface{}, error) {
+	built = built.withDialect(dialectMySQL)
+	if err := built.lockError("mysql", ForUpdate, ForShare); err != nil {
+		return nil, err
+	}
+	if err := built.arrayError("mysql"); err != nil {
+		return nil, err
+	}
+
 	// ⭐ Insert scenario: may need UPSERT or IGNORE
 	if built.Inserts != nil {
 		return c.generateInsert(built)
@@ -161,8 +170,15 @@ func (c *MySQLCustom) Generate(built *Built) (interface{}, error) {
 		return &SQLResult{SQL: sql, Args: vs, Meta: km}, nil
 	}
 
-	// ⭐ Select/Delete scenario (uses default implementation)
+	// ⭐ Delete scenario
 	// Note: MySQL DELETE syntax is consistent with standard SQL, no special handling needed
+	if built.Delete {
+		vs := []interface{}{}
+		sql := built.sqlDelete(&vs)
+		return &SQLResult{SQL: sql, Args: vs}, nil
+	}
+
+	// ⭐ Select scenario (uses default implementation)
 	vs := []interface{}{}
 	km := make(map[string]string)
 	sql, kmp := built.SqlData(&vs, km)
@@ -273,6 +289,7 @@ func DefaultMySQLCustom() *MySQLCustom {
 // Notes:
 //   - No need to set Custom, call directly
 //   - Automatically generates ON DUPLICATE KEY UPDATE clause
+//   - MySQL syntax, for PostgreSQL / SQLite use OnConflict() of PostgresCustom / SQLiteCustom
 //
 // Returns:
 //   - string: SQL statement
@@ -318,6 +335,7 @@ func (built *Built) SqlOfUpsert() (string, []interface{}) {
 // Notes:
 //   - No need to set Custom, call directly
 //   - Ignores duplicate key errors, doesn't throw exceptions
+//   - MySQL syntax, for SQLite use NewSQLiteBuilder().OrIgnore()
 //
 // Returns:
 //   - string: SQL statement
@@ -0,0 +1,152 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"strings"
+
+	. "github.com/fndome/xb/internal"
+)
+
+// IndexScope FOR JOIN / ORDER BY / GROUP BY of the index hints (MySQL), nil for all
+type IndexScope func() string
+
+func ForJoin() string {
+	return "FOR JOIN"
+}
+
+func ForOrderBy() string {
+	return "FOR ORDER BY"
+}
+
+func ForGroupBy() string {
+	return "FOR GROUP BY"
+}
+
+// UseIndex USE INDEX (idx) of the table reference (MySQL), ignored by the other Customs
+//
+// Example:
+//
+//	xb.Of("t_order").As("o").
+//	    FromX(func(fb *xb.FromBuilder) {
+//	        fb.ForceIndex(nil, "idx_created_at").
+//	            JOIN(xb.STRAIGHT).Of("t_user").As("u").IgnoreIndex(xb.ForJoin, "idx_name").On("u.id = o.user_id")
+//	    }).
+//	    Build()
+//	// SELECT * FROM t_order o FORCE INDEX (idx_created_at) STRAIGHT_JOIN t_user u IGNORE INDEX FOR JOIN (idx_name) ON u.id = o.user_id
+func (fb *FromBuilder) UseIndex(scope IndexScope, idx ...string) *FromBuilder {
+	fb.x.indexHints = appendIndexHint(fb.x.indexHints, "USE INDEX", scope, idx)
+	return fb
+}
+
+// ForceIndex FORCE INDEX (idx) of the table reference (MySQL), see UseIndex()
+func (fb *FromBuilder) ForceIndex(scope IndexScope, idx ...string) *FromBuilder {
+	fb.x.indexHints = appendIndexHint(fb.x.indexHints, "FORCE INDEX", scope, idx)
+	return fb
+}
+
+// IgnoreIndex IGNORE INDEX (idx) of the table reference (MySQL), see UseIndex()
+func (fb *FromBuilder) IgnoreIndex(scope IndexScope, idx ...string) *FromBuilder {
+	fb.x.indexHints = appendIndexHint(fb.x.indexHints, "IGNORE INDEX", scope, idx)
+	return fb
+}
+
+// UseIndex USE INDEX (idx) of the table of Of() (MySQL), see FromBuilder.UseIndex()
+func (x *BuilderX) UseIndex(scope IndexScope, idx ...string) *BuilderX {
+	return x.indexHint("USE INDEX", scope, idx)
+}
+
+// ForceIndex FORCE INDEX (idx) of the table of Of() (MySQL), see FromBuilder.UseIndex()
+func (x *BuilderX) ForceIndex(scope IndexScope, idx ...string) *BuilderX {
+	return x.indexHint("FORCE INDEX", scope, idx)
+}
+
+// IgnoreIndex IGNORE INDEX (idx) of the table of Of() (MySQL), see FromBuilder.UseIndex()
+func (x *BuilderX) IgnoreIndex(scope IndexScope, idx ...string) *BuilderX {
+	return x.indexHint("IGNORE INDEX", scope, idx)
+}
+
+func (x *BuilderX) indexHint(kind string, scope IndexScope, idx []string) *BuilderX {
+	if len(x.sxs) > 0 {
+		x.sxs[0].indexHints = appendIndexHint(x.sxs[0].indexHints, kind, scope, idx)
+	} else {
+		x.indexHints = appendIndexHint(x.indexHints, kind, scope, idx)
+	}
+	return x
+}
+
+func appendIndexHint(hints []string, kind string, scope IndexScope, idx []string) []string {
+	if len(idx) == 0 {
+		panic(kind + "(scope, idx...), idx can not be empty")
+	}
+	hint := kind
+	if scope != nil {
+		hint += SPACE + scope()
+	}
+	return append(hints, hint+" ("+strings.Join(idx, COMMA)+")")
+}
+
+// OptimizerHint /*+ hint ... */ right after SELECT (MySQL), ignored by the other Customs
+//
+// Example:
+//
+//	xb.Of("t_order").OptimizerHint("MAX_EXECUTION_TIME(1000)", "INDEX(t_order idx_created_at)").Build()
+//	// SELECT /*+ MAX_EXECUTION_TIME(1000) INDEX(t_order idx_created_at) */ * FROM t_order
+func (x *BuilderX) OptimizerHint(hints ...string) *BuilderX {
+	for _, hint := range hints {
+		if strings.Contains(hint, "*/") {
+			panic("OptimizerHint(hints...), */ is not allowed: " + hint)
+		}
+		if hint != "" {
+			x.optimizerHints = append(x.optimizerHints, hint)
+		}
+	}
+	return x
+}
+
+func (built *Built) toOptimizerHintSql(bp *strings.Builder) {
+	if len(built.optimizerHints) == 0 || !built.isMySQL() {
+		return
+	}
+	bp.WriteString("/*+ ")
+	bp.WriteString(strings.Join(built.optimizerHints, SPACE))
+	bp.WriteString(" */ ")
+}
+
+func (built *Built) toIndexHintSql(bp *strings.Builder, hints []string) {
+	if len(hints) == 0 || !built.isMySQL() {
+		return
+	}
+	for _, hint := range hints {
+		bp.WriteString(SPACE)
+		bp.WriteString(hint)
+	}
+}
+
+// joinOf STRAIGHT_JOIN is INNER JOIN for the other Customs
+func (built *Built) joinOf(join string) string {
+	if join == straight_join && !built.isMySQL() {
+		return inner_join
+	}
+	return join
+}
+
+// isMySQL MySQL Custom, or the default SQL (MySQL compatible) without Custom
+func (built *Built) isMySQL() bool {
+	return built.dialect == "" || built.dialect == dialectMySQL
+}
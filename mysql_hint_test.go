@@ -0,0 +1,129 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"testing"
+)
+
+func TestMySQLHints(t *testing.T) {
+	sql, _, _ := Of("t_order").As("o").
+		Custom(NewMySQLBuilder().Build()).
+		OptimizerHint("MAX_EXECUTION_TIME(1000)", "INDEX(o idx_created_at)").
+		ForceIndex(nil, "idx_created_at").
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(STRAIGHT).Of("t_user").As("u").IgnoreIndex(ForJoin, "idx_name", "idx_email").On("u.id = o.user_id")
+		}).
+		Build().
+		SqlOfSelect()
+
+	want := "SELECT /*+ MAX_EXECUTION_TIME(1000) INDEX(o idx_created_at) */ * FROM t_order o FORCE INDEX (idx_created_at) " +
+		"STRAIGHT_JOIN t_user u IGNORE INDEX FOR JOIN (idx_name, idx_email) ON u.id = o.user_id"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+}
+
+func TestMySQLHints_Default(t *testing.T) {
+	sql, _, _ := Of("t_order").As("o").
+		OptimizerHint("NO_ICP(o)").
+		UseIndex(ForOrderBy, "idx_a").
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(STRAIGHT).Of("t_user").As("u").On("u.id = o.user_id")
+		}).
+		Eq("u.status", 1).
+		Build().
+		SqlOfSelect()
+
+	want := "SELECT /*+ NO_ICP(o) */ * FROM t_order o USE INDEX FOR ORDER BY (idx_a) STRAIGHT_JOIN t_user u ON u.id = o.user_id WHERE u.status = ?"
+	if sql != want {
+		t.Errorf("default SQL should keep the hints\ngot:  %s\nwant: %s", sql, want)
+	}
+}
+
+func TestMySQLHints_IgnoredByPostgreSQL(t *testing.T) {
+	sql, _, _ := Of("t_order").As("o").
+		Custom(NewPostgresBuilder().Build()).
+		OptimizerHint("MAX_EXECUTION_TIME(1000)").
+		ForceIndex(nil, "idx_created_at").
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(STRAIGHT).Of("t_user").As("u").IgnoreIndex(ForJoin, "idx_name").On("u.id = o.user_id")
+		}).
+		Build().
+		SqlOfSelect()
+
+	want := "SELECT * FROM t_order o INNER JOIN t_user u ON u.id = o.user_id"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+}
+
+func TestMySQLHints_IgnoredBySQLite(t *testing.T) {
+	sql, _, _ := Of("t_order").
+		Custom(NewSQLiteBuilder().Build()).
+		UseIndex(ForOrderBy, "idx_a").
+		OptimizerHint("NO_ICP(t_order)").
+		Build().
+		SqlOfSelect()
+
+	if sql != "SELECT * FROM t_order" {
+		t.Errorf("unexpected: %s", sql)
+	}
+}
+
+func TestMySQLHints_SubqueryFollowsDialect(t *testing.T) {
+	sub := func(sb *BuilderX) {
+		sb.From("t_user").ForceIndex(nil, "idx_a").Gt("id", 1)
+	}
+
+	sql, _, _ := Of("t_order").As("o").
+		Custom(NewMySQLBuilder().Build()).
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(INNER).Sub(sub).As("u").On("u.id = o.user_id")
+		}).
+		Build().
+		SqlOfSelect()
+	if sql != "SELECT * FROM t_order o INNER JOIN (SELECT * FROM t_user FORCE INDEX (idx_a) WHERE id > ?) u ON u.id = o.user_id" {
+		t.Errorf("unexpected MySQL: %s", sql)
+	}
+
+	sql, _, _ = Of("t_order").As("o").
+		Custom(NewPostgresBuilder().Build()).
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(INNER).Sub(sub).As("u").On("u.id = o.user_id")
+		}).
+		Build().
+		SqlOfSelect()
+	if sql != "SELECT * FROM t_order o INNER JOIN (SELECT * FROM t_user WHERE id > $1) u ON u.id = o.user_id" {
+		t.Errorf("unexpected PostgreSQL: %s", sql)
+	}
+}
+
+func TestMySQLHints_OfWithoutJoin(t *testing.T) {
+	sql, _, _ := Of("t_order").UseIndex(ForOrderBy, "idx_a").Build().SqlOfSelect()
+	if sql != "SELECT * FROM t_order USE INDEX FOR ORDER BY (idx_a)" {
+		t.Errorf("unexpected: %s", sql)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("*/ in hint should panic")
+		}
+	}()
+	Of("t_order").OptimizerHint("x */ DROP")
+}
@@ -17,25 +17,35 @@
 package xb
 
 const (
-	XX       = ""
-	AGG      = ""
-	SUB      = "SUB"
-	AND      = "AND"
-	OR       = "OR"
-	AND_SUB  = AND
-	OR_SUB   = OR
-	EQ       = "="
-	NE       = "<>"
-	GT       = ">"
-	LT       = "<"
-	GTE      = ">="
-	LTE      = "<="
-	LIKE     = "LIKE"
-	NOT_LIKE = "NOT LIKE"
-	IN       = "IN"
-	NIN      = "NOT IN"
-	IS_NULL  = "IS NULL"
-	NON_NULL = "IS NOT NULL"
+	XX        = ""
+	AGG       = ""
+	SUB       = "SUB"
+	AND       = "AND"
+	OR        = "OR"
+	AND_SUB   = AND
+	OR_SUB    = OR
+	EQ        = "="
+	NE        = "<>"
+	GT        = ">"
+	LT        = "<"
+	GTE       = ">="
+	LTE       = "<="
+	LIKE      = "LIKE"
+	NOT_LIKE  = "NOT LIKE"
+	ILIKE     = "ILIKE"
+	IN        = "IN"
+	NIN       = "NOT IN"
+	IN_ANY    = "= ANY"
+	NIN_ALL   = "<> ALL"
+	IS_NULL   = "IS NULL"
+	NON_NULL  = "IS NOT NULL"
+	MATCH     = "MATCH"
+	JSON_PATH = "JSON_PATH"
+
+	ARRAY_CONTAINS     = "@>"
+	ARRAY_OVERLAPS     = "&&"
+	ARRAY_CONTAINED_BY = "<@"
+	ANY_EQ             = "ANY_EQ"
 )
 
 type Op func() string
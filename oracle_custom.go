@@ -0,0 +1,257 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ============================================================================
+// OracleBuilder: Builder Pattern Configuration Builder
+// ============================================================================
+
+// OracleBuilder Oracle configuration builder
+// Uses Builder pattern to construct OracleCustom configuration
+type OracleBuilder struct {
+	custom *OracleCustom
+}
+
+// NewOracleBuilder creates an Oracle configuration builder
+//
+// Example:
+//
+//	xb.Of(...).Custom(
+//	    xb.NewOracleBuilder().
+//	        UseRowNum(true).          // Oracle 11g and earlier
+//	        QuoteIdentifiers(true).
+//	        Build(),
+//	).Build()
+func NewOracleBuilder() *OracleBuilder {
+	return &OracleBuilder{
+		custom: newOracleCustom(),
+	}
+}
+
+// UseRowNum sets whether to use ROWNUM pagination (Oracle 11g and earlier)
+// Default false: OFFSET n ROWS FETCH NEXT m ROWS ONLY (Oracle 12c+)
+func (ob *OracleBuilder) UseRowNum(use bool) *OracleBuilder {
+	ob.custom.UseRowNum = use
+	return ob
+}
+
+// QuoteIdentifiers sets whether to quote identifiers: name → "name", the aliases of FROM / JOIN too
+// Raw SQL (X(), On()) is kept as is, quote its identifiers in the same way
+func (ob *OracleBuilder) QuoteIdentifiers(quote bool) *OracleBuilder {
+	ob.custom.QuoteIdentifiers = quote
+	return ob
+}
+
+// Build constructs and returns OracleCustom configuration
+func (ob *OracleBuilder) Build() *OracleCustom {
+	return ob.custom
+}
+
+// ============================================================================
+// OracleCustom: Oracle-Specific Configuration
+// ============================================================================
+
+// OracleCustom Oracle database-specific configuration
+//
+// Notes:
+//   - Placeholders are numbered as :1, :2 ...
+//   - Pagination: OFFSET n ROWS FETCH NEXT m ROWS ONLY (12c+), or ROWNUM (UseRowNum)
+//   - SqlOfPage() gets CountSQL from Generate(), the COUNT doesn't contain pagination
+//
+// Example:
+//
+//	built := xb.Of("users").
+//	    Custom(xb.DefaultOracleCustom()).
+//	    Gt("age", 18).
+//	    Sort("id", xb.ASC).
+//	    Paged(func(pb *xb.PageBuilder) {
+//	        pb.Page(3).Rows(10)
+//	    }).
+//	    Build()
+//
+//	countSql, dataSql, args, _ := built.SqlOfPage()
+//	// SELECT COUNT(*) FROM users WHERE age > :1
+//	// SELECT * FROM users WHERE age > :1 ORDER BY id ASC OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY
+type OracleCustom struct {
+	// UseRowNum uses ROWNUM pagination (Oracle 11g and earlier)
+	UseRowNum bool
+
+	// QuoteIdentifiers quotes plain identifiers: name → "name", t.name → "t"."name", FROM t u → FROM "t" "u"
+	QuoteIdentifiers bool
+}
+
+// newOracleCustom internal function: creates default Oracle Custom
+func newOracleCustom() *OracleCustom {
+	return &OracleCustom{}
+}
+
+// ============================================================================
+// Implements Custom Interface
+// ============================================================================
+
+// Generate implements Custom interface
+//
+// Returns:
+//   - interface{}: *SQLResult (:N placeholders, CountSQL for pagination)
+//   - error: error information
+func (c *OracleCustom) Generate(built *Built) (interface{}, error) {
+	if c.QuoteIdentifiers {
+		built = built.withQuotedIdents(`"`, `"`)
+	}
+	built = built.withDialect(dialectOracle).withLikeDialect(LikeEscapeChar, false)
+	if err := built.fullTextError("oracle"); err != nil {
+		return nil, err
+	}
+	if err := built.jsonPathError("oracle"); err != nil {
+		return nil, err
+	}
+	if err := built.arrayError("oracle"); err != nil {
+		return nil, err
+	}
+	if err := built.lockError("oracle", ForUpdate); err != nil {
+		return nil, err
+	}
+
+	vs := []interface{}{}
+
+	// ⭐ Insert scenario (InsertRows(): INSERT ALL INTO t ... SELECT 1 FROM DUAL)
+	if built.Inserts != nil {
+		var sql string
+		if len(built.InsertRows) > 1 {
+			sql = c.insertAllSql(built, &vs)
+		} else {
+			sql = built.SqlInsert(&vs)
+		}
+		return &SQLResult{SQL: built.numberPlaceholders(sql, ":"), Args: vs}, nil
+	}
+
+	// ⭐ Delete scenario
+	if built.Delete {
+		if err := built.joinedDeleteError("oracle"); err != nil {
+			return nil, err
+		}
+		sql := built.sqlDelete(&vs)
+		return &SQLResult{SQL: built.numberPlaceholders(sql, ":"), Args: vs}, nil
+	}
+
+	// ⭐ Select / Update scenario
+	if err := built.joinedUpdateError("oracle"); err != nil {
+		return nil, err
+	}
+	km := make(map[string]string)
+	offset, rows := built.pageRange()
+	if built.lock != nil && built.Updates == nil && (rows > 0 || offset > 0) {
+		return nil, &DialectError{Dialect: "oracle", Reason: "Lock() can not be used with pagination (ROWNUM / FETCH)"}
+	}
+
+	var sql string
+	if c.UseRowNum && built.Updates == nil && (rows > 0 || offset > 0) {
+		sql, km = built.sqlDataOf(&vs, km, nil)
+		sql = c.wrapRowNum(sql, offset, rows)
+	} else {
+		sql, km = built.sqlDataOf(&vs, km, func(bp *strings.Builder) {
+			c.toFetchSql(bp, offset, rows)
+		})
+	}
+
+	return &SQLResult{
+		SQL:      built.numberPlaceholders(sql, ":"),
+		CountSQL: built.numberPlaceholders(built.SqlCount(), ":"),
+		Args:     vs,
+		Meta:     km,
+	}, nil
+}
+
+// ============================================================================
+// Internal Implementation
+// ============================================================================
+
+// toFetchSql writes OFFSET n ROWS FETCH NEXT m ROWS ONLY (Oracle 12c+)
+func (c *OracleCustom) toFetchSql(bp *strings.Builder, offset int, rows int) {
+	if offset > 0 {
+		bp.WriteString(" OFFSET ")
+		bp.WriteString(strconv.Itoa(offset))
+		bp.WriteString(" ROWS")
+	}
+	if rows > 0 {
+		if offset > 0 {
+			bp.WriteString(" FETCH NEXT ")
+		} else {
+			bp.WriteString(" FETCH FIRST ")
+		}
+		bp.WriteString(strconv.Itoa(rows))
+		bp.WriteString(" ROWS ONLY")
+	}
+}
+
+// insertAllSql generates INSERT ALL of InsertRows() (no multi-row VALUES before Oracle 23c)
+// INSERT ALL INTO t (a, b) VALUES (?, ?) INTO t (a, b) VALUES (?, NULL) SELECT 1 FROM DUAL
+func (c *OracleCustom) insertAllSql(built *Built, vs *[]interface{}) string {
+	sb := strings.Builder{}
+	sb.WriteString("INSERT ALL")
+	for _, row := range built.InsertRows {
+		rowBuilt := *built
+		rowBuilt.InsertRows = [][]Bb{row}
+		sb.WriteString(strings.TrimPrefix(rowBuilt.SqlInsert(vs), "INSERT"))
+	}
+	sb.WriteString(" SELECT 1 FROM DUAL")
+	return sb.String()
+}
+
+// wrapRowNum wraps the data SQL with ROWNUM pagination (Oracle 11g and earlier)
+//
+//	SELECT * FROM (SELECT a.*, ROWNUM rn FROM (...) a WHERE ROWNUM <= 30) WHERE rn > 20
+func (c *OracleCustom) wrapRowNum(sql string, offset int, rows int) string {
+	if offset == 0 {
+		return "SELECT * FROM (" + sql + ") WHERE ROWNUM <= " + strconv.Itoa(rows)
+	}
+
+	sb := strings.Builder{}
+	sb.Grow(len(sql) + 96)
+	sb.WriteString("SELECT * FROM (SELECT a.*, ROWNUM rn FROM (")
+	sb.WriteString(sql)
+	sb.WriteString(") a")
+	if rows > 0 {
+		sb.WriteString(" WHERE ROWNUM <= ")
+		sb.WriteString(strconv.Itoa(offset + rows))
+	}
+	sb.WriteString(") WHERE rn > ")
+	sb.WriteString(strconv.Itoa(offset))
+	return sb.String()
+}
+
+// ============================================================================
+// Default Oracle Custom (Global Singleton)
+// ============================================================================
+
+// defaultOracleCustom default Oracle Custom instance
+var defaultOracleCustom = newOracleCustom()
+
+// DefaultOracleCustom gets default Oracle Custom (singleton, Oracle 12c+)
+//
+// Example:
+//
+//	xb.CustomGlobal(xb.DefaultOracleCustom())
+func DefaultOracleCustom() *OracleCustom {
+	return defaultOracleCustom
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"testing"
+)
+
+func TestOracleCustom_FetchPage(t *testing.T) {
+	built := Of("users").
+		Custom(DefaultOracleCustom()).
+		Gt("age", 18).
+		Eq("status", "active").
+		Sort("id", ASC).
+		Paged(func(pb *PageBuilder) {
+			pb.Page(3).Rows(10)
+		}).
+		Build()
+
+	countSql, dataSql, args, _ := built.SqlOfPage()
+
+	wantData := "SELECT * FROM users WHERE age > :1 AND status = :2 ORDER BY id ASC OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY"
+	if dataSql != wantData {
+		t.Errorf("data got:  %s\nwant: %s", dataSql, wantData)
+	}
+	wantCount := "SELECT COUNT(*) FROM users WHERE age > :1 AND status = :2"
+	if countSql != wantCount {
+		t.Errorf("count got:  %s\nwant: %s", countSql, wantCount)
+	}
+	if len(args) != 2 {
+		t.Errorf("expected 2 args, got %v", args)
+	}
+}
+
+func TestOracleCustom_FetchFirst(t *testing.T) {
+	sql, _, _ := Of("users").
+		Custom(DefaultOracleCustom()).
+		Sort("id", DESC).
+		Limit(5).
+		Build().
+		SqlOfSelect()
+
+	if sql != "SELECT * FROM users ORDER BY id DESC FETCH FIRST 5 ROWS ONLY" {
+		t.Errorf("unexpected SQL: %s", sql)
+	}
+}
+
+func TestOracleCustom_RowNum(t *testing.T) {
+	built := Of("users").
+		Custom(NewOracleBuilder().UseRowNum(true).Build()).
+		Gt("age", 18).
+		Sort("id", ASC).
+		Paged(func(pb *PageBuilder) {
+			pb.Page(3).Rows(10)
+		}).
+		Build()
+
+	countSql, dataSql, _, _ := built.SqlOfPage()
+
+	wantData := "SELECT * FROM (SELECT a.*, ROWNUM rn FROM (SELECT * FROM users WHERE age > :1 ORDER BY id ASC) a" +
+		" WHERE ROWNUM <= 30) WHERE rn > 20"
+	if dataSql != wantData {
+		t.Errorf("data got:  %s\nwant: %s", dataSql, wantData)
+	}
+	if countSql != "SELECT COUNT(*) FROM users WHERE age > :1" {
+		t.Errorf("unexpected count: %s", countSql)
+	}
+
+	sql, _, _ := Of("users").
+		Custom(NewOracleBuilder().UseRowNum(true).Build()).
+		Paged(func(pb *PageBuilder) {
+			pb.Page(1).Rows(10)
+		}).
+		Build().
+		SqlOfSelect()
+	if sql != "SELECT * FROM (SELECT * FROM users) WHERE ROWNUM <= 10" {
+		t.Errorf("unexpected first page: %s", sql)
+	}
+}
+
+func TestOracleCustom_QuoteIdentifiers(t *testing.T) {
+	sql, args, _ := Of("users").
+		Custom(NewOracleBuilder().QuoteIdentifiers(true).Build()).
+		Select("id", "u.name", "COUNT(*) AS cnt").
+		Eq("level", 3).
+		Or(func(cb *CondBuilder) {
+			cb.Eq("type", "a").OR().Eq("type", "b")
+		}).
+		X("ROWNUM < 100").
+		Build().
+		SqlOfSelect()
+
+	want := `SELECT "id", "u"."name" AS c0, COUNT(*) AS cnt FROM "users" WHERE "level" = :1 AND ("type" = :2 OR "type" = :3) AND ROWNUM < 100`
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+	if len(args) != 3 {
+		t.Errorf("expected 3 args, got %v", args)
+	}
+
+	sql, _ = Of("users").
+		Custom(NewOracleBuilder().QuoteIdentifiers(true).Build()).
+		Insert(func(ib *InsertBuilder) {
+			ib.Set("name", "Tom").Set("level", 3)
+		}).
+		Build().
+		SqlOfInsert()
+	if sql != `INSERT INTO "users" ("name", "level") VALUES ( :1,  :2)` {
+		t.Errorf("unexpected insert: %s", sql)
+	}
+}
+
+func TestOracleCustom_QuoteIdentifiersWithAlias(t *testing.T) {
+	sql, _, _ := Of("users").As("u").
+		Custom(NewOracleBuilder().QuoteIdentifiers(true).Build()).
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(INNER).Of("orders").As("o").On(`"o"."user_id" = "u"."id"`)
+		}).
+		Eq("u.id", 1).
+		Build().
+		SqlOfSelect()
+
+	want := `SELECT * FROM "users" "u" INNER JOIN "orders" "o" ON "o"."user_id" = "u"."id" WHERE "u"."id" = :1`
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+
+	sql, _, _ = Of("users u").
+		Custom(NewOracleBuilder().QuoteIdentifiers(true).Build()).
+		Eq("u.id", 1).
+		Build().
+		SqlOfSelect()
+	if sql != `SELECT * FROM "users" "u" WHERE "u"."id" = :1` {
+		t.Errorf("unexpected SQL: %s", sql)
+	}
+}
@@ -16,11 +16,25 @@
 // limitations under the License.
 package xb
 
+// CountStrategy the form of COUNT SQL of Paged()
+type CountStrategy int
+
+const (
+	CountAuto    CountStrategy = iota // ⭐ CountWrapped with GROUP BY / HAVING / DISTINCT / UNION, else CountPlain
+	CountPlain                        // SELECT COUNT(*) FROM t WHERE ...
+	CountWrapped                      // SELECT COUNT(*) FROM (SELECT ... FROM t WHERE ... GROUP BY ...) t
+)
+
 type PageCondition struct {
 	Page               uint
 	Rows               uint
 	Last               uint64
 	IsTotalRowsIgnored bool
+	Keyset             bool          // ⭐ keyset (cursor) pagination by all sorts, see Cursor()
+	After              []interface{} // sort values of the last row of the previous page (Keyset)
+	Count              CountStrategy
+
+	fromCursor bool // After is decoded from the token of Cursor()
 }
 
 type PageBuilder struct {
@@ -54,7 +68,53 @@ func (pb *PageBuilder) IgnoreTotalRows() *PageBuilder {
 	return pb
 }
 
+// CountBy forces the form of COUNT SQL, default: CountAuto
+func (pb *PageBuilder) CountBy(strategy CountStrategy) *PageBuilder {
+	pb.condition.Count = strategy
+	return pb
+}
+
 func (pb *PageBuilder) SetTotalRowsIgnored(ignored bool) *PageBuilder {
 	pb.condition.IsTotalRowsIgnored = ignored
 	return pb
 }
+
+// Cursor keyset pagination by all sorts, token: built.NextCursor() of the previous page, "" for the first page
+// WHERE (created_at < ? OR (created_at = ? AND id < ?)) ... LIMIT rows, no OFFSET, no COUNT
+//
+// Notes:
+//   - Mixed ASC / DESC is supported, values can be numeric, string, time.Time, UUID ...
+//   - An invalid token (or one made for other sorts) is the first page,
+//     to reject it (e.g. 400), decode the token by DecodeCursor() and pass to After()
+//
+// Example:
+//
+//	xb.Of("t_post").
+//	    Sort("created_at", xb.DESC).
+//	    Sort("id", xb.DESC).
+//	    Paged(func(pb *xb.PageBuilder) {
+//	        pb.Rows(20).Cursor(req.Cursor)
+//	    }).
+//	    Build()
+func (pb *PageBuilder) Cursor(token string) *PageBuilder {
+	if token == "" {
+		return pb.After()
+	}
+	values, err := DecodeCursor(token)
+	if err != nil {
+		return pb.After()
+	}
+	pb.After(values...)
+	pb.condition.fromCursor = true
+	return pb
+}
+
+// After keyset pagination by all sorts, values: sort values of the last row of the previous page
+// no values for the first page
+func (pb *PageBuilder) After(values ...interface{}) *PageBuilder {
+	pb.condition.Keyset = true
+	pb.condition.IsTotalRowsIgnored = true
+	pb.condition.After = values
+	pb.condition.fromCursor = false
+	return pb
+}
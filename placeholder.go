@@ -0,0 +1,75 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"strconv"
+	"strings"
+
+	. "github.com/fndome/xb/internal"
+)
+
+// numberPlaceholders rewrites each ? bind marker as prefix + N (N starts at 1)
+// Used by dialects that don't accept ?, e.g. PostgreSQL ($1), Oracle (:1), SQL Server (@p1)
+//
+// Notes:
+//   - The SQL of CTEs, subqueries, unions and joins is already inlined when this runs,
+//     so numbering follows the final args order
+//   - ? inside quoted literals or identifiers ('...', "...", `...`) is kept as is
+//   - ?| and ?& are kept as the jsonb operators (PostgreSQL), ?? is written as the ? operator
+//   - ?|| is a bind marker followed by || (string concatenation), not ?|
+func numberPlaceholders(sql string, prefix string) string {
+	if !strings.Contains(sql, PLACE_HOLDER_MARK) {
+		return sql
+	}
+
+	sb := strings.Builder{}
+	sb.Grow(len(sql) + 16)
+	n := 0
+	var quote byte
+	for i := 0; i < len(sql); i++ {
+		ch := sql[i]
+		switch {
+		case quote != 0:
+			if ch == quote {
+				quote = 0
+			}
+		case ch == '\'' || ch == '"' || ch == '`':
+			quote = ch
+		case ch == '?' && i+1 < len(sql) && sql[i+1] == '?':
+			i++
+		case ch == '?' && i+1 < len(sql) && sql[i+1] == '&':
+		case ch == '?' && i+1 < len(sql) && sql[i+1] == '|' && (i+2 >= len(sql) || sql[i+2] != '|'):
+		case ch == '?':
+			n++
+			sb.WriteString(prefix)
+			sb.WriteString(strconv.Itoa(n))
+			continue
+		}
+		sb.WriteByte(ch)
+	}
+	return sb.String()
+}
+
+// numberPlaceholders numbers the placeholders of the outermost statement only
+func (built *Built) numberPlaceholders(sql string, prefix string) string {
+	if built.nested {
+		return sql
+	}
+	return numberPlaceholders(sql, prefix)
+}
@@ -0,0 +1,315 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ============================================================================
+// PostgresBuilder: Builder Pattern Configuration Builder
+// ============================================================================
+
+// PostgresBuilder PostgreSQL configuration builder
+// Uses Builder pattern to construct PostgresCustom configuration
+type PostgresBuilder struct {
+	custom *PostgresCustom
+}
+
+// NewPostgresBuilder creates a PostgreSQL configuration builder
+//
+// Example:
+//
+//	xb.Of(...).Custom(
+//	    xb.NewPostgresBuilder().
+//	        OnConflict("id").
+//	        DoUpdate().
+//	        Returning("id", "updated_at").
+//	        Build(),
+//	).Build()
+func NewPostgresBuilder() *PostgresBuilder {
+	return &PostgresBuilder{
+		custom: newPostgresCustom(),
+	}
+}
+
+// Returning sets the RETURNING columns of INSERT / UPDATE / DELETE
+func (pb *PostgresBuilder) Returning(cols ...string) *PostgresBuilder {
+	pb.custom.Returning = append(pb.custom.Returning, cols...)
+	return pb
+}
+
+// OnConflict sets the conflict target: ON CONFLICT (cols)
+func (pb *PostgresBuilder) OnConflict(cols ...string) *PostgresBuilder {
+	pb.custom.ConflictKeys = append(pb.custom.ConflictKeys, cols...)
+	return pb
+}
+
+// DoNothing sets ON CONFLICT ... DO NOTHING
+func (pb *PostgresBuilder) DoNothing() *PostgresBuilder {
+	pb.custom.ConflictDoNothing = true
+	return pb
+}
+
+// DoUpdate sets ON CONFLICT (...) DO UPDATE SET col = EXCLUDED.col
+// If no cols, all inserted columns except the conflict target are updated
+func (pb *PostgresBuilder) DoUpdate(cols ...string) *PostgresBuilder {
+	pb.custom.ConflictDoUpdate = true
+	pb.custom.ConflictUpdates = append(pb.custom.ConflictUpdates, cols...)
+	return pb
+}
+
+// TextSearchConfig sets the text search config of Match(): to_tsvector('english', ...)
+func (pb *PostgresBuilder) TextSearchConfig(config string) *PostgresBuilder {
+	if !identRegex.MatchString(config) {
+		panic("TextSearchConfig(config), invalid config: " + config)
+	}
+	pb.custom.TextSearchConfig = config
+	return pb
+}
+
+// Build constructs and returns PostgresCustom configuration
+func (pb *PostgresBuilder) Build() *PostgresCustom {
+	return pb.custom
+}
+
+// ============================================================================
+// PostgresCustom: PostgreSQL-Specific Configuration
+// ============================================================================
+
+// PostgresCustom PostgreSQL database-specific configuration
+//
+// Notes:
+//   - Placeholders are numbered as $1, $2 ... (pgx / lib/pq don't accept ?)
+//   - jsonb operators in X(): ?| and ?& are kept, write the ? operator as ??
+//   - Numbering is done on the final SQL, so CTEs, subqueries, unions and joins share one sequence
+//
+// Use cases:
+//   - RETURNING of INSERT / UPDATE / DELETE
+//   - UPSERT: ON CONFLICT (cols) DO UPDATE / DO NOTHING
+//
+// Example:
+//
+//	// Global (no builder API needed for placeholders)
+//	xb.CustomGlobal(xb.DefaultPostgresCustom())
+//
+//	// Per builder
+//	built := xb.Of("users").Custom(xb.NewPostgresBuilder().Returning("id").Build()).Build()
+type PostgresCustom struct {
+	// Returning columns of RETURNING (INSERT / UPDATE / DELETE)
+	Returning []string
+
+	// ConflictKeys conflict target of ON CONFLICT (cols)
+	ConflictKeys []string
+
+	// ConflictDoNothing uses ON CONFLICT ... DO NOTHING
+	ConflictDoNothing bool
+
+	// ConflictDoUpdate uses ON CONFLICT (cols) DO UPDATE SET col = EXCLUDED.col
+	ConflictDoUpdate bool
+
+	// ConflictUpdates columns of DO UPDATE (empty: all inserted columns except ConflictKeys)
+	ConflictUpdates []string
+
+	// TextSearchConfig text search config of Match(), e.g. english (empty: default_text_search_config)
+	TextSearchConfig string
+}
+
+// newPostgresCustom internal function: creates default PostgreSQL Custom
+func newPostgresCustom() *PostgresCustom {
+	return &PostgresCustom{}
+}
+
+// ============================================================================
+// Implements Custom Interface
+// ============================================================================
+
+// Generate implements Custom interface
+//
+// Returns:
+//   - interface{}: *SQLResult ($N placeholders)
+//   - error: error information
+func (c *PostgresCustom) Generate(built *Built) (interface{}, error) {
+	built = built.withDialect(dialectPostgres).withLikeDialect("", true).withTsConfig(c.TextSearchConfig)
+	if err := built.lockError("postgres"); err != nil {
+		return nil, err
+	}
+	vs := []interface{}{}
+
+	// ⭐ Insert scenario: may need ON CONFLICT, RETURNING
+	if built.Inserts != nil {
+		sql, err := c.insertSql(built, &vs)
+		if err != nil {
+			return nil, err
+		}
+		return &SQLResult{SQL: built.numberPlaceholders(sql, "$"), Args: vs}, nil
+	}
+
+	// ⭐ Update scenario
+	if built.Updates != nil {
+		km := make(map[string]string)
+		sql := ""
+		if built.isJoinedUpdate() {
+			var err error
+			if sql, err = built.sqlUpdateFrom(&vs, "postgres"); err != nil {
+				return nil, err
+			}
+		} else {
+			sql, _ = built.SqlData(&vs, km)
+		}
+		sql += c.returningClause()
+		return &SQLResult{SQL: built.numberPlaceholders(sql, "$"), Args: vs, Meta: km}, nil
+	}
+
+	// ⭐ Delete scenario
+	if built.Delete {
+		sql := ""
+		if built.isJoinedDelete() {
+			var err error
+			if sql, err = built.sqlDeleteUsing(&vs); err != nil {
+				return nil, err
+			}
+		} else {
+			sql = built.sqlDelete(&vs)
+		}
+		sql += c.returningClause()
+		return &SQLResult{SQL: built.numberPlaceholders(sql, "$"), Args: vs}, nil
+	}
+
+	// ⭐ Select scenario
+	km := make(map[string]string)
+	sql, kmp := built.SqlData(&vs, km)
+	return &SQLResult{
+		SQL:      built.numberPlaceholders(sql, "$"),
+		CountSQL: built.numberPlaceholders(built.SqlCount(), "$"),
+		Args:     vs,
+		Meta:     kmp,
+	}, nil
+}
+
+// ============================================================================
+// Internal Implementation
+// ============================================================================
+
+// insertSql generates PostgreSQL INSERT statement (with ? placeholders)
+func (c *PostgresCustom) insertSql(built *Built, vs *[]interface{}) (string, error) {
+	sql := built.SqlInsert(vs)
+
+	if c.ConflictDoUpdate || c.ConflictDoNothing {
+		clause, err := c.onConflictClause(*built.Inserts)
+		if err != nil {
+			return "", err
+		}
+		sql += clause
+	}
+
+	return sql + c.returningClause(), nil
+}
+
+// onConflictClause builds ON CONFLICT (cols) DO UPDATE SET ... / DO NOTHING
+func (c *PostgresCustom) onConflictClause(inserts []Bb) (string, error) {
+	return onConflictSql(c.ConflictKeys, c.ConflictDoNothing, c.ConflictUpdates, inserts, "EXCLUDED")
+}
+
+// onConflictSql builds ON CONFLICT (keys) DO UPDATE SET col = excluded.col / DO NOTHING
+// Shared by PostgreSQL and SQLite, excluded is the pseudo table name
+func onConflictSql(keys []string, doNothing bool, updates []string, inserts []Bb, excluded string) (string, error) {
+	sb := strings.Builder{}
+	sb.WriteString(" ON CONFLICT")
+	if len(keys) > 0 {
+		sb.WriteString(" (")
+		sb.WriteString(strings.Join(keys, ", "))
+		sb.WriteString(")")
+	}
+
+	if doNothing {
+		sb.WriteString(" DO NOTHING")
+		return sb.String(), nil
+	}
+
+	if len(keys) == 0 {
+		return "", fmt.Errorf("ON CONFLICT DO UPDATE requires OnConflict(cols)")
+	}
+
+	cols := updates
+	if len(cols) == 0 {
+		for _, bb := range inserts {
+			if !hasKey(keys, bb.Key) {
+				cols = append(cols, bb.Key)
+			}
+		}
+	}
+	if len(cols) == 0 {
+		sb.WriteString(" DO NOTHING")
+		return sb.String(), nil
+	}
+
+	sb.WriteString(" DO UPDATE SET ")
+	for i, col := range cols {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(col)
+		sb.WriteString(" = ")
+		sb.WriteString(excluded)
+		sb.WriteString(".")
+		sb.WriteString(col)
+	}
+	return sb.String(), nil
+}
+
+// returningClause builds RETURNING cols
+func (c *PostgresCustom) returningClause() string {
+	if len(c.Returning) == 0 {
+		return ""
+	}
+	return " RETURNING " + strings.Join(c.Returning, ", ")
+}
+
+func hasKey(keys []string, key string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// ============================================================================
+// Default PostgreSQL Custom (Global Singleton)
+// ============================================================================
+
+// defaultPostgresCustom default PostgreSQL Custom instance
+var defaultPostgresCustom = newPostgresCustom()
+
+// DefaultPostgresCustom gets default PostgreSQL Custom (singleton)
+//
+// Notes:
+//   - Only numbers placeholders ($1, $2 ...), no RETURNING / ON CONFLICT
+//   - Suitable for CustomGlobal()
+//
+// Example:
+//
+//	xb.CustomGlobal(xb.DefaultPostgresCustom())
+//
+//	sql, args, _ := xb.Of("users").Eq("id", 1).Build().SqlOfSelect()
+//	// SELECT * FROM users WHERE id = $1
+func DefaultPostgresCustom() *PostgresCustom {
+	return defaultPostgresCustom
+}
@@ -0,0 +1,202 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPostgresCustom_NumbersPlaceholders(t *testing.T) {
+	built := Of("orders").
+		Custom(DefaultPostgresCustom()).
+		With("vip", func(sb *BuilderX) {
+			sb.From("users").Select("id").Eq("level", 3)
+		}).
+		Eq("status", "paid").
+		Sub("user_id IN ?", func(sb *BuilderX) {
+			sb.From("vip").Select("id").Gt("id", 10)
+		}).
+		In("type", "a", "b").
+		UNION(ALL, func(sb *BuilderX) {
+			sb.From("archived_orders").Eq("status", "paid")
+		}).
+		Build()
+
+	sql, args, _ := built.SqlOfSelect()
+
+	want := "WITH vip AS (SELECT id FROM users WHERE level = $1) " +
+		"SELECT * FROM orders WHERE status = $2 AND user_id IN (SELECT id FROM vip WHERE id > $3) " +
+		"AND type IN ($4, $5) UNION ALL (SELECT * FROM archived_orders WHERE status = $6)"
+	if sql != want {
+		t.Fatalf("got:  %s\nwant: %s", sql, want)
+	}
+	if len(args) != 6 {
+		t.Errorf("expected 6 args, got %v", args)
+	}
+}
+
+func TestPostgresCustom_JoinAndPage(t *testing.T) {
+	built := Of("orders").As("o").
+		Custom(DefaultPostgresCustom()).
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(INNER).Of("users").As("u").On("u.id = o.user_id").
+				Cond(func(on *ON) {
+					on.Eq("u.status", "active")
+				})
+		}).
+		Select("o.id", "u.name").
+		Gt("o.amount", 100).
+		Paged(func(pb *PageBuilder) {
+			pb.Page(2).Rows(10)
+		}).
+		Build()
+
+	countSql, dataSql, args, _ := built.SqlOfPage()
+
+	if !strings.Contains(dataSql, "u.status = $1") || !strings.Contains(dataSql, "o.amount > $2") {
+		t.Errorf("unexpected data SQL: %s", dataSql)
+	}
+	if !strings.Contains(countSql, "o.amount > $2") {
+		t.Errorf("count SQL should be numbered: %s", countSql)
+	}
+	if len(args) != 2 {
+		t.Errorf("expected 2 args, got %v", args)
+	}
+}
+
+func TestPostgresCustom_KeepsQuotedMark(t *testing.T) {
+	sql, _, _ := Of("t").
+		Custom(DefaultPostgresCustom()).
+		X("note <> '?'").
+		Eq("id", 1).
+		Build().
+		SqlOfSelect()
+
+	if sql != "SELECT * FROM t WHERE note <> '?' AND id = $1" {
+		t.Errorf("unexpected SQL: %s", sql)
+	}
+}
+
+func TestPostgresCustom_JsonbOperators(t *testing.T) {
+	sql, args, _ := Of("t").
+		Custom(DefaultPostgresCustom()).
+		X("tags ?| array['a', 'b']").
+		X("tags ?& array[?]", "c").
+		X("attrs ?? 'color'").
+		Eq("id", 1).
+		Build().
+		SqlOfSelect()
+
+	want := "SELECT * FROM t WHERE tags ?| array['a', 'b'] AND tags ?& array[$1] AND attrs ? 'color' AND id = $2"
+	if sql != want {
+		t.Errorf("\ngot:  %s\nwant: %s", sql, want)
+	}
+	if len(args) != 2 {
+		t.Errorf("args: %v", args)
+	}
+}
+
+func TestPostgresCustom_ConcatAfterPlaceholder(t *testing.T) {
+	sql, args, _ := Of("t").
+		Custom(DefaultPostgresCustom()).
+		X("name LIKE ?||'%'", "ab").
+		X("tags ?| array[?]", "c").
+		Eq("id", 1).
+		Build().
+		SqlOfSelect()
+
+	want := "SELECT * FROM t WHERE name LIKE $1||'%' AND tags ?| array[$2] AND id = $3"
+	if sql != want {
+		t.Errorf("\ngot:  %s\nwant: %s", sql, want)
+	}
+	if len(args) != 3 {
+		t.Errorf("args: %v", args)
+	}
+}
+
+func TestPostgresCustom_UpsertReturning(t *testing.T) {
+	built := Of("users").
+		Custom(NewPostgresBuilder().OnConflict("id").DoUpdate().Returning("id").Build()).
+		Insert(func(ib *InsertBuilder) {
+			ib.Set("id", 1).Set("name", "Tom").Set("age", 18)
+		}).
+		Build()
+
+	sql, args := built.SqlOfInsert()
+
+	want := "INSERT INTO users (id, name, age) VALUES ( $1,  $2,  $3)" +
+		" ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name, age = EXCLUDED.age RETURNING id"
+	if sql != want {
+		t.Fatalf("got:  %s\nwant: %s", sql, want)
+	}
+	if len(args) != 3 {
+		t.Errorf("expected 3 args, got %v", args)
+	}
+}
+
+func TestPostgresCustom_DoNothing(t *testing.T) {
+	sql, _ := Of("users").
+		Custom(NewPostgresBuilder().OnConflict("email").DoNothing().Build()).
+		Insert(func(ib *InsertBuilder) {
+			ib.Set("email", "a@b.c")
+		}).
+		Build().
+		SqlOfInsert()
+
+	if sql != "INSERT INTO users (email) VALUES ( $1) ON CONFLICT (email) DO NOTHING" {
+		t.Errorf("unexpected SQL: %s", sql)
+	}
+}
+
+func TestPostgresCustom_UpdateDeleteReturning(t *testing.T) {
+	custom := NewPostgresBuilder().Returning("id", "name").Build()
+
+	sql, args := Of("users").
+		Custom(custom).
+		Update(func(ub *UpdateBuilder) {
+			ub.Set("name", "Tom")
+		}).
+		Eq("id", 7).
+		Build().
+		SqlOfUpdate()
+	if sql != "UPDATE users SET name = $1  WHERE id = $2 RETURNING id, name" || len(args) != 2 {
+		t.Errorf("unexpected update: %s %v", sql, args)
+	}
+
+	sql, args = Of("users").
+		Custom(custom).
+		Eq("id", 7).
+		Build().
+		SqlOfDelete()
+	if sql != "DELETE FROM users WHERE id = $1 RETURNING id, name" || len(args) != 1 {
+		t.Errorf("unexpected delete: %s %v", sql, args)
+	}
+}
+
+func TestPostgresCustom_ILike(t *testing.T) {
+	sql, args, _ := Of("users").
+		Custom(DefaultPostgresCustom()).
+		ILike("name", "tom").
+		Build().
+		SqlOfSelect()
+
+	if sql != "SELECT * FROM users WHERE name ILIKE $1" || args[0] != "%tom%" {
+		t.Errorf("unexpected: %s %v", sql, args)
+	}
+}
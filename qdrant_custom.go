@@ -318,13 +318,25 @@ func (c *QdrantCustom) generateInsertJSON(built *Built) (string, error) {
 
 	points := []QdrantPoint{}
 
-	// ⭐ Using Insert(func(ib)) format
-	// Multiple bbs (field-value pairs) form one point
-	point, err := c.extractPointFromBbs(inserts)
-	if err != nil {
-		return "", err
+	// ⭐ Using InsertRows(func(rb)) format
+	// Each row forms one point, one upsert with many points
+	if len(built.InsertRows) > 0 {
+		for i, row := range built.InsertRows {
+			point, err := c.extractPointFromBbs(row)
+			if err != nil {
+				return "", fmt.Errorf("row %d: %w", i, err)
+			}
+			points = append(points, point)
+		}
+	} else {
+		// ⭐ Using Insert(func(ib)) format
+		// Multiple bbs (field-value pairs) form one point
+		point, err := c.extractPointFromBbs(inserts)
+		if err != nil {
+			return "", err
+		}
+		points = append(points, point)
 	}
-	points = append(points, point)
 
 	req := QdrantUpsertRequest{Points: points}
 	bytes, err := json.MarshalIndent(req, "", "  ")
@@ -446,12 +458,10 @@ func (c *QdrantCustom) extractIdsOrFilter(conds []Bb) ([]interface{}, *QdrantFil
 	// Find id IN (...) condition
 	for _, bb := range conds {
 		if bb.Key == "id" {
-			if bb.Op == IN {
+			if bb.Op == IN || bb.Op == IN_ANY {
 				// IN condition: extract ID list
-				if arr, ok := bb.Value.(*[]string); ok {
-					for _, id := range *arr {
-						ids = append(ids, id)
-					}
+				if arr, ok := bb.Value.([]interface{}); ok {
+					ids = append(ids, arr...)
 					return ids, nil
 				}
 			} else if bb.Op == EQ {
@@ -0,0 +1,177 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"regexp"
+	"strings"
+)
+
+// identRegex plain identifier, optionally qualified: name, t.name, schema.t.name, t.*
+var identRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$#]*(\.([A-Za-z_][A-Za-z0-9_$#]*|\*))*$`)
+
+// quoteIdent quotes each part of a plain identifier: t.name → "t"."name"
+// Expressions (functions, aliases, raw SQL) are returned as is
+func quoteIdent(name string, left string, right string) string {
+	if !identRegex.MatchString(name) {
+		return name
+	}
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		if part != "*" {
+			parts[i] = left + part + right
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// unquoteIdent removes the quotes of each part: [dbo].[t] / "t" / `t` → dbo.t / t
+func unquoteIdent(name string) string {
+	if !strings.ContainsAny(name, "[]\"`") {
+		return name
+	}
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		parts[i] = strings.Trim(part, "[]\"`")
+	}
+	return strings.Join(parts, ".")
+}
+
+// quoteFrom quotes the table and the alias of "table" or "table alias",
+// the alias is quoted as the qualifier of the quoted columns ("u"."id")
+func quoteFrom(from string, left string, right string) string {
+	fields := strings.Fields(from)
+	if len(fields) == 0 || len(fields) > 2 || strings.EqualFold(fields[0], "FROM") {
+		return from
+	}
+	for i, field := range fields {
+		fields[i] = quoteIdent(field, left, right)
+	}
+	return strings.Join(fields, " ")
+}
+
+// withQuotedIdents returns a copy of built, the plain identifiers are quoted
+// (table, alias, select keys, condition keys, insert/update columns, sorts, group by)
+// Used by dialects with quoted identifiers, e.g. Oracle ("name"), SQL Server ([name])
+func (built *Built) withQuotedIdents(left string, right string) *Built {
+	cloned := *built
+	q := func(name string) string {
+		return quoteIdent(name, left, right)
+	}
+
+	cloned.OrFromSql = quoteFrom(built.OrFromSql, left, right)
+	cloned.Alia = quoteIdent(built.Alia, left, right)
+
+	if built.ResultKeys != nil {
+		cloned.ResultKeys = make([]string, len(built.ResultKeys))
+		for i, k := range built.ResultKeys {
+			cloned.ResultKeys[i] = q(k)
+		}
+	}
+	if built.selectCases != nil {
+		cloned.selectCases = make([]selectCase, len(built.selectCases))
+		for i, sc := range built.selectCases {
+			sc.c = sc.c.quoted(q)
+			cloned.selectCases[i] = sc
+		}
+	}
+	if built.GroupBys != nil {
+		cloned.GroupBys = make([]string, len(built.GroupBys))
+		for i, k := range built.GroupBys {
+			cloned.GroupBys[i] = q(k)
+		}
+	}
+	if built.Sorts != nil {
+		cloned.Sorts = make([]Sort, len(built.Sorts))
+		for i, sort := range built.Sorts {
+			sort.orderBy = q(sort.orderBy)
+			if sort.caseOf != nil {
+				sort.caseOf = sort.caseOf.quoted(q)
+			}
+			cloned.Sorts[i] = sort
+		}
+	}
+
+	cloned.Conds = quoteBbKeys(built.Conds, q)
+	cloned.Havings = quoteBbKeys(built.Havings, q)
+	if built.Inserts != nil {
+		inserts := quoteColumnKeys(*built.Inserts, q)
+		cloned.Inserts = &inserts
+	}
+	if built.InsertRows != nil {
+		cloned.InsertRows = make([][]Bb, len(built.InsertRows))
+		for i, row := range built.InsertRows {
+			cloned.InsertRows[i] = quoteColumnKeys(row, q)
+		}
+	}
+	if built.Updates != nil {
+		updates := quoteColumnKeys(*built.Updates, q)
+		cloned.Updates = &updates
+	}
+
+	if built.Fxs != nil {
+		cloned.Fxs = make([]*FromX, len(built.Fxs))
+		for i, fx := range built.Fxs {
+			x := *fx
+			x.tableName = quoteIdent(fx.tableName, left, right)
+			x.alia = quoteIdent(fx.alia, left, right)
+			if fx.join != nil && fx.join.on != nil {
+				on := *fx.join.on
+				on.bbs = quoteBbKeys(fx.join.on.bbs, q)
+				x.join = &Join{join: fx.join.join, on: &on}
+			}
+			cloned.Fxs[i] = &x
+		}
+	}
+	return &cloned
+}
+
+// quoteBbKeys copies bbs with quoted keys (raw X() / SET fragments are kept as is)
+func quoteBbKeys(bbs []Bb, q func(string) string) []Bb {
+	if bbs == nil {
+		return nil
+	}
+	arr := make([]Bb, len(bbs))
+	for i, bb := range bbs {
+		switch bb.Op {
+		case XX, SUB, AND, OR:
+		default:
+			bb.Key = q(bb.Key)
+		}
+		if len(bb.Subs) > 0 {
+			bb.Subs = quoteBbKeys(bb.Subs, q)
+		}
+		arr[i] = bb
+	}
+	return arr
+}
+
+// quoteColumnKeys copies insert/update bbs with quoted columns (raw SET fragments are kept as is)
+func quoteColumnKeys(bbs []Bb, q func(string) string) []Bb {
+	arr := make([]Bb, len(bbs))
+	for i, bb := range bbs {
+		if bb.Op != "SET" {
+			bb.Key = q(bb.Key)
+		}
+		if c, ok := bb.Value.(*CaseBuilder); ok {
+			bb.Value = c.quoted(q)
+		}
+		arr[i] = bb
+	}
+	return arr
+}
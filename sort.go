@@ -24,6 +24,9 @@ const (
 type Sort struct {
 	orderBy   string
 	direction string
+	args      []interface{} // ⭐ args of SortCase() / SortRank(), set when rendered
+	rank      bool          // ⭐ SortRank(), rendered by the dialect
+	caseOf    *CaseBuilder  // ⭐ SortCase(), rendered by the dialect
 }
 
 type Direction func() string
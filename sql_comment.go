@@ -0,0 +1,126 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package xb
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fndome/xb/interceptor"
+)
+
+// DefaultSqlCommentKeys keys written by SqlComment() without keys
+// UserID and the other keys of Metadata.Custom are not written unless allowed explicitly
+var DefaultSqlCommentKeys = []string{
+	"traceparent", "tracestate", "route", "controller", "action", "application",
+	"trace_id", "request_id", "tenant_id",
+}
+
+// SqlComment appends a sqlcommenter comment of Meta() to the generated SQL
+//
+// Notes:
+//   - keys is the allow-list, DefaultSqlCommentKeys if empty
+//   - trace_id / request_id / user_id / tenant_id are TraceID / RequestID / UserID / TenantID,
+//     the other keys are read from Metadata.Custom
+//   - Keys are sorted, values are URL-encoded and quoted, empty values are skipped
+//   - Applies to SqlOfSelect / SqlOfPage / SqlOfCount / SqlOfInsert / SqlOfUpdate / SqlOfDelete
+//
+// Example:
+//
+//	xb.Of("t_order").
+//	    Meta(func(meta *interceptor.Metadata) {
+//	        meta.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+//	        meta.Set("route", "/api/orders")
+//	    }).
+//	    SqlComment().
+//	    Eq("status", 1).
+//	    Build()
+//	// SELECT * FROM t_order WHERE status = ? /*route='%2Fapi%2Forders',traceparent='00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01'*/
+func (x *BuilderX) SqlComment(keys ...string) *BuilderX {
+	if len(keys) == 0 {
+		keys = DefaultSqlCommentKeys
+	}
+	x.commentKeys = append([]string{}, keys...)
+	return x
+}
+
+// commented appends the sqlcommenter comment of SqlComment() to sql
+func (built *Built) commented(sql string) string {
+	if sql == "" || built.nested || len(built.commentKeys) == 0 || built.Meta == nil {
+		return sql
+	}
+	comment := sqlComment(built.Meta, built.commentKeys)
+	if comment == "" {
+		return sql
+	}
+	return sql + " " + comment
+}
+
+func sqlComment(meta *interceptor.Metadata, keys []string) string {
+	keys = append([]string{}, keys...)
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for i, k := range keys {
+		if i > 0 && k == keys[i-1] {
+			continue
+		}
+		v := sqlCommentValue(meta, k)
+		if v == "" {
+			continue
+		}
+		pairs = append(pairs, sqlCommentEscape(k)+"='"+sqlCommentEscape(v)+"'")
+	}
+	if len(pairs) == 0 {
+		return ""
+	}
+	return "/*" + strings.Join(pairs, ",") + "*/"
+}
+
+func sqlCommentValue(meta *interceptor.Metadata, key string) string {
+	switch key {
+	case "trace_id":
+		if meta.TraceID != "" {
+			return meta.TraceID
+		}
+	case "request_id":
+		if meta.RequestID != "" {
+			return meta.RequestID
+		}
+	case "user_id":
+		if meta.UserID != 0 {
+			return strconv.FormatInt(meta.UserID, 10)
+		}
+	case "tenant_id":
+		if meta.TenantID != 0 {
+			return strconv.FormatInt(meta.TenantID, 10)
+		}
+	}
+	v := meta.Get(key)
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+// sqlCommentEscape URL-encodes s (' as %27, * as %2A), so that it can't close the comment or the quotes
+func sqlCommentEscape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
@@ -0,0 +1,132 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package xb
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fndome/xb/interceptor"
+)
+
+func tagged(meta func(meta *interceptor.Metadata)) *BuilderX {
+	return Of("t_order").Meta(meta).Eq("status", 1)
+}
+
+func TestSqlComment_DefaultKeys(t *testing.T) {
+	sql, args, _ := tagged(func(meta *interceptor.Metadata) {
+		meta.TraceID = "t-1"
+		meta.UserID = 42
+		meta.TenantID = 7
+		meta.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+		meta.Set("route", "/api/orders")
+		meta.Set("email", "a@b.c")
+	}).SqlComment().Build().SqlOfSelect()
+
+	want := "SELECT * FROM t_order WHERE status = ? " +
+		"/*route='%2Fapi%2Forders',tenant_id='7'," +
+		"trace_id='t-1',traceparent='00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01'*/"
+	if sql != want {
+		t.Errorf("\ngot:  %s\nwant: %s", sql, want)
+	}
+	if len(args) != 1 {
+		t.Errorf("args: %v", args)
+	}
+}
+
+func TestSqlComment_AllowList(t *testing.T) {
+	sql, _, _ := tagged(func(meta *interceptor.Metadata) {
+		meta.TraceID = "t-1"
+		meta.UserID = 42
+		meta.Set("email", "a@b.c")
+	}).SqlComment("user_id", "action").Build().SqlOfSelect()
+
+	if !strings.HasSuffix(sql, " /*user_id='42'*/") {
+		t.Errorf("got: %s", sql)
+	}
+	if strings.Contains(sql, "t-1") || strings.Contains(sql, "email") {
+		t.Errorf("keys outside of the allow-list leaked: %s", sql)
+	}
+}
+
+func TestSqlComment_Escape(t *testing.T) {
+	sql, _, _ := tagged(func(meta *interceptor.Metadata) {
+		meta.Set("route", "x'*/ DROP TABLE t_order; -- ")
+	}).SqlComment().Build().SqlOfSelect()
+
+	want := "SELECT * FROM t_order WHERE status = ? /*route='x%27%2A%2F%20DROP%20TABLE%20t_order%3B%20--%20'*/"
+	if sql != want {
+		t.Errorf("\ngot:  %s\nwant: %s", sql, want)
+	}
+}
+
+func TestSqlComment_Skipped(t *testing.T) {
+	want := "SELECT * FROM t_order WHERE status = ?"
+
+	// Without SqlComment()
+	sql, _, _ := tagged(func(meta *interceptor.Metadata) {
+		meta.Set("route", "/api/orders")
+	}).Build().SqlOfSelect()
+	if sql != want {
+		t.Errorf("got: %s", sql)
+	}
+
+	// Without allowed values
+	sql, _, _ = tagged(func(meta *interceptor.Metadata) {
+		meta.Set("email", "a@b.c")
+	}).SqlComment().Build().SqlOfSelect()
+	if sql != want {
+		t.Errorf("got: %s", sql)
+	}
+}
+
+func TestSqlComment_CustomPageAndWrite(t *testing.T) {
+	meta := func(meta *interceptor.Metadata) {
+		meta.RequestID = "r-1"
+	}
+	pg := NewPostgresBuilder().Build()
+
+	countSql, dataSql, _, _ := Of("t_order").Custom(pg).Meta(meta).SqlComment().
+		With("recent", func(sb *BuilderX) {
+			sb.From("t_order").Gte("id", 100)
+		}).
+		Eq("status", 1).
+		Paged(func(pb *PageBuilder) { pb.Page(1).Rows(10) }).
+		Build().SqlOfPage()
+	if !strings.HasSuffix(dataSql, "OFFSET 0 /*request_id='r-1'*/") ||
+		strings.Count(dataSql, "/*") != 1 || !strings.Contains(dataSql, "$1") {
+		t.Errorf("data: %s", dataSql)
+	}
+	if !strings.HasSuffix(countSql, " /*request_id='r-1'*/") {
+		t.Errorf("count: %s", countSql)
+	}
+
+	sql, _ := Of("t_order").Meta(meta).SqlComment().
+		Update(func(ub *UpdateBuilder) { ub.Set("status", 2) }).
+		Eq("id", 1).
+		Build().SqlOfUpdate()
+	if !strings.HasSuffix(sql, "WHERE id = ? /*request_id='r-1'*/") {
+		t.Errorf("update: %s", sql)
+	}
+
+	sql, _ = Of("t_order").Meta(meta).SqlComment().
+		Insert(func(ib *InsertBuilder) { ib.Set("status", 1) }).
+		Build().SqlOfInsert()
+	if !strings.HasSuffix(sql, "VALUES ( ?) /*request_id='r-1'*/") {
+		t.Errorf("insert: %s", sql)
+	}
+}
@@ -0,0 +1,285 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"strings"
+	"time"
+)
+
+// SQLiteTimeLayout layout of time.Time args, the same as datetime() and InsertBuilder / UpdateBuilder / Eq ...
+// Fixed width, so strings of it are ordered as the time
+const SQLiteTimeLayout = "2006-01-02 15:04:05"
+
+// ============================================================================
+// SQLiteBuilder: Builder Pattern Configuration Builder
+// ============================================================================
+
+// SQLiteBuilder SQLite configuration builder
+// Uses Builder pattern to construct SQLiteCustom configuration
+type SQLiteBuilder struct {
+	custom *SQLiteCustom
+}
+
+// NewSQLiteBuilder creates a SQLite configuration builder
+//
+// Example:
+//
+//	xb.Of(...).Custom(
+//	    xb.NewSQLiteBuilder().
+//	        OnConflict("id").
+//	        DoUpdate().
+//	        Returning("id").
+//	        Build(),
+//	).Build()
+func NewSQLiteBuilder() *SQLiteBuilder {
+	return &SQLiteBuilder{
+		custom: newSQLiteCustom(),
+	}
+}
+
+// OnConflict sets the conflict target: ON CONFLICT (cols)
+func (sb *SQLiteBuilder) OnConflict(cols ...string) *SQLiteBuilder {
+	sb.custom.ConflictKeys = append(sb.custom.ConflictKeys, cols...)
+	return sb
+}
+
+// DoNothing sets ON CONFLICT ... DO NOTHING
+func (sb *SQLiteBuilder) DoNothing() *SQLiteBuilder {
+	sb.custom.ConflictDoNothing = true
+	return sb
+}
+
+// DoUpdate sets ON CONFLICT (...) DO UPDATE SET col = excluded.col
+// If no cols, all inserted columns except the conflict target are updated
+func (sb *SQLiteBuilder) DoUpdate(cols ...string) *SQLiteBuilder {
+	sb.custom.ConflictDoUpdate = true
+	sb.custom.ConflictUpdates = append(sb.custom.ConflictUpdates, cols...)
+	return sb
+}
+
+// OrIgnore sets INSERT OR IGNORE INTO
+func (sb *SQLiteBuilder) OrIgnore() *SQLiteBuilder {
+	sb.custom.InsertOr = "IGNORE"
+	return sb
+}
+
+// OrReplace sets INSERT OR REPLACE INTO
+func (sb *SQLiteBuilder) OrReplace() *SQLiteBuilder {
+	sb.custom.InsertOr = "REPLACE"
+	return sb
+}
+
+// Returning sets the RETURNING columns of INSERT / UPDATE / DELETE (SQLite 3.35+)
+func (sb *SQLiteBuilder) Returning(cols ...string) *SQLiteBuilder {
+	sb.custom.Returning = append(sb.custom.Returning, cols...)
+	return sb
+}
+
+// Build constructs and returns SQLiteCustom configuration
+func (sb *SQLiteBuilder) Build() *SQLiteCustom {
+	return sb.custom
+}
+
+// ============================================================================
+// SQLiteCustom: SQLite-Specific Configuration
+// ============================================================================
+
+// SQLiteCustom SQLite database-specific configuration
+//
+// Notes:
+//   - Placeholders are ? (same as default)
+//   - bool args are bound as 1 / 0
+//   - time.Time args (e.g. of X(), In()) are bound as SQLiteTimeLayout strings, as Set() / Eq() ... do,
+//     so they compare correctly as TEXT (use UTC times to compare with CURRENT_TIMESTAMP)
+//   - Use it instead of SqlOfUpsert() / SqlOfInsertIgnore(), which generate MySQL syntax
+//
+// Example:
+//
+//	// Global
+//	xb.CustomGlobal(xb.DefaultSQLiteCustom())
+//
+//	// Per builder
+//	built := xb.Of("users").
+//	    Custom(xb.NewSQLiteBuilder().OnConflict("id").DoUpdate().Build()).
+//	    Insert(func(ib *xb.InsertBuilder) {
+//	        ib.Set("id", 1).Set("name", "Alice")
+//	    }).
+//	    Build()
+//
+//	sql, args := built.SqlOfInsert()
+//	// INSERT INTO users (id, name) VALUES (?, ?) ON CONFLICT (id) DO UPDATE SET name = excluded.name
+type SQLiteCustom struct {
+	// ConflictKeys conflict target of ON CONFLICT (cols)
+	ConflictKeys []string
+
+	// ConflictDoNothing uses ON CONFLICT ... DO NOTHING
+	ConflictDoNothing bool
+
+	// ConflictDoUpdate uses ON CONFLICT (cols) DO UPDATE SET col = excluded.col
+	ConflictDoUpdate bool
+
+	// ConflictUpdates columns of DO UPDATE (empty: all inserted columns except ConflictKeys)
+	ConflictUpdates []string
+
+	// InsertOr conflict resolution of INSERT OR IGNORE / INSERT OR REPLACE
+	InsertOr string
+
+	// Returning columns of RETURNING (INSERT / UPDATE / DELETE)
+	Returning []string
+}
+
+// newSQLiteCustom internal function: creates default SQLite Custom
+func newSQLiteCustom() *SQLiteCustom {
+	return &SQLiteCustom{}
+}
+
+// ============================================================================
+// Implements Custom Interface
+// ============================================================================
+
+// Generate implements Custom interface
+//
+// Returns:
+//   - interface{}: *SQLResult
+//   - error: error information
+func (c *SQLiteCustom) Generate(built *Built) (interface{}, error) {
+	built = built.withDialect(dialectSQLite).withLikeDialect(LikeEscapeChar, false)
+	if err := built.jsonContainsError("sqlite"); err != nil {
+		return nil, err
+	}
+	if err := built.noLockError("sqlite"); err != nil {
+		return nil, err
+	}
+	if err := built.arrayError("sqlite"); err != nil {
+		return nil, err
+	}
+	vs := []interface{}{}
+
+	// ⭐ Insert scenario: may need OR IGNORE / OR REPLACE, ON CONFLICT, RETURNING
+	if built.Inserts != nil {
+		sql := built.SqlInsert(&vs)
+		if c.InsertOr != "" {
+			sql = strings.Replace(sql, "INSERT INTO", "INSERT OR "+c.InsertOr+" INTO", 1)
+		}
+		if c.ConflictDoUpdate || c.ConflictDoNothing {
+			clause, err := onConflictSql(c.ConflictKeys, c.ConflictDoNothing, c.ConflictUpdates, *built.Inserts, "excluded")
+			if err != nil {
+				return nil, err
+			}
+			sql += clause
+		}
+		sql += c.returningClause()
+		return &SQLResult{SQL: sql, Args: c.toArgs(vs)}, nil
+	}
+
+	// ⭐ Update scenario
+	if built.Updates != nil {
+		km := make(map[string]string)
+		sql := ""
+		if built.isJoinedUpdate() {
+			var err error
+			if sql, err = built.sqlUpdateFrom(&vs, "sqlite"); err != nil {
+				return nil, err
+			}
+		} else {
+			sql, _ = built.SqlData(&vs, km)
+		}
+		sql += c.returningClause()
+		return &SQLResult{SQL: sql, Args: c.toArgs(vs), Meta: km}, nil
+	}
+
+	// ⭐ Delete scenario
+	if built.Delete {
+		if err := built.joinedDeleteError("sqlite"); err != nil {
+			return nil, err
+		}
+		sql := built.sqlDelete(&vs)
+		sql += c.returningClause()
+		return &SQLResult{SQL: sql, Args: c.toArgs(vs)}, nil
+	}
+
+	// ⭐ Select scenario
+	km := make(map[string]string)
+	sql, kmp := built.SqlData(&vs, km)
+	return &SQLResult{
+		SQL:  sql,
+		Args: c.toArgs(vs),
+		Meta: kmp,
+	}, nil
+}
+
+// ============================================================================
+// Internal Implementation
+// ============================================================================
+
+// returningClause builds RETURNING cols
+func (c *SQLiteCustom) returningClause() string {
+	if len(c.Returning) == 0 {
+		return ""
+	}
+	return " RETURNING " + strings.Join(c.Returning, ", ")
+}
+
+// toArgs converts bool to 1 / 0 and time.Time to SQLiteTimeLayout
+func (c *SQLiteCustom) toArgs(vs []interface{}) []interface{} {
+	for i, v := range vs {
+		switch v := v.(type) {
+		case bool:
+			vs[i] = sqliteBool(v)
+		case *bool:
+			if v != nil {
+				vs[i] = sqliteBool(*v)
+			}
+		case time.Time:
+			vs[i] = v.Format(SQLiteTimeLayout)
+		case *time.Time:
+			if v != nil {
+				vs[i] = v.Format(SQLiteTimeLayout)
+			}
+		}
+	}
+	return vs
+}
+
+func sqliteBool(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// ============================================================================
+// Default SQLite Custom (Global Singleton)
+// ============================================================================
+
+// defaultSQLiteCustom default SQLite Custom instance
+var defaultSQLiteCustom = newSQLiteCustom()
+
+// DefaultSQLiteCustom gets default SQLite Custom (singleton)
+//
+// Notes:
+//   - Only converts bool / time.Time args, no ON CONFLICT / RETURNING
+//   - Suitable for CustomGlobal()
+//
+// Example:
+//
+//	xb.CustomGlobal(xb.DefaultSQLiteCustom())
+func DefaultSQLiteCustom() *SQLiteCustom {
+	return defaultSQLiteCustom
+}
@@ -0,0 +1,128 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSQLiteCustom_OnConflictDoUpdate(t *testing.T) {
+	built := Of("users").
+		Custom(NewSQLiteBuilder().OnConflict("id").DoUpdate().Returning("id").Build()).
+		Insert(func(ib *InsertBuilder) {
+			ib.Set("id", 1).Set("name", "Alice").Set("age", 18)
+		}).
+		Build()
+
+	sql, args := built.SqlOfInsert()
+
+	want := "INSERT INTO users (id, name, age) VALUES ( ?,  ?,  ?)" +
+		" ON CONFLICT (id) DO UPDATE SET name = excluded.name, age = excluded.age RETURNING id"
+	if sql != want {
+		t.Fatalf("got:  %s\nwant: %s", sql, want)
+	}
+	if len(args) != 3 {
+		t.Errorf("expected 3 args, got %v", args)
+	}
+}
+
+func TestSQLiteCustom_InsertOr(t *testing.T) {
+	built := Of("users").
+		Custom(NewSQLiteBuilder().OrIgnore().Build()).
+		Insert(func(ib *InsertBuilder) {
+			ib.Set("id", 1)
+		}).
+		Build()
+
+	sql, _ := built.SqlOfInsert()
+	if sql != "INSERT OR IGNORE INTO users (id) VALUES ( ?)" {
+		t.Errorf("unexpected SQL: %s", sql)
+	}
+
+	built = Of("users").
+		Custom(NewSQLiteBuilder().OrReplace().Build()).
+		Insert(func(ib *InsertBuilder) {
+			ib.Set("id", 1)
+		}).
+		Build()
+
+	sql, _ = built.SqlOfInsert()
+	if sql != "INSERT OR REPLACE INTO users (id) VALUES ( ?)" {
+		t.Errorf("unexpected SQL: %s", sql)
+	}
+}
+
+func TestSQLiteCustom_DoUpdateRequiresTarget(t *testing.T) {
+	built := Of("users").
+		Insert(func(ib *InsertBuilder) {
+			ib.Set("id", 1)
+		}).
+		Build()
+
+	_, err := NewSQLiteBuilder().DoUpdate().Build().Generate(built)
+	if err == nil {
+		t.Fatal("expected error without OnConflict(cols)")
+	}
+}
+
+func TestSQLiteCustom_BoolAndTimeArgs(t *testing.T) {
+	at := time.Date(2025, 1, 2, 3, 4, 5, 6000000, time.UTC)
+
+	built := Of("users").
+		Custom(DefaultSQLiteCustom()).
+		Update(func(ub *UpdateBuilder) {
+			ub.Set("active", true).Set("updated_at", at)
+		}).
+		Eq("id", 1).
+		Build()
+
+	_, args := built.SqlOfUpdate()
+
+	if args[0] != 1 {
+		t.Errorf("bool should be bound as 1, got %#v", args[0])
+	}
+	if args[1] != "2025-01-02 03:04:05" {
+		t.Errorf("unexpected time arg: %#v", args[1])
+	}
+
+	built = Of("users").
+		Custom(DefaultSQLiteCustom()).
+		X("created_at > ? AND deleted = ?", at, false).
+		Build()
+
+	_, args, _ = built.SqlOfSelect()
+	if args[0] != "2025-01-02 03:04:05" {
+		t.Errorf("time of X() should be bound as the same layout, got %#v", args[0])
+	}
+	if args[1] != 0 {
+		t.Errorf("bool should be bound as 0, got %#v", args[1])
+	}
+}
+
+func TestSQLiteCustom_DeleteReturning(t *testing.T) {
+	built := Of("users").
+		Custom(NewSQLiteBuilder().Returning("id", "name").Build()).
+		Eq("id", 1).
+		Build()
+
+	sql, _ := built.SqlOfDelete()
+	if sql != "DELETE FROM users WHERE id = ? RETURNING id, name" {
+		t.Errorf("unexpected SQL: %s", sql)
+	}
+}
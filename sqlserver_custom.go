@@ -0,0 +1,284 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"strconv"
+	"strings"
+
+	. "github.com/fndome/xb/internal"
+)
+
+// ============================================================================
+// SQLServerBuilder: Builder Pattern Configuration Builder
+// ============================================================================
+
+// SQLServerBuilder SQL Server configuration builder
+// Uses Builder pattern to construct SQLServerCustom configuration
+type SQLServerBuilder struct {
+	custom *SQLServerCustom
+}
+
+// NewSQLServerBuilder creates a SQL Server configuration builder
+//
+// Example:
+//
+//	xb.Of(...).Custom(
+//	    xb.NewSQLServerBuilder().
+//	        OutputInserted("id").
+//	        Build(),
+//	).Build()
+func NewSQLServerBuilder() *SQLServerBuilder {
+	return &SQLServerBuilder{
+		custom: newSQLServerCustom(),
+	}
+}
+
+// QuoteIdentifiers sets whether to bracket-quote identifiers: name → [name] (default true)
+func (sb *SQLServerBuilder) QuoteIdentifiers(quote bool) *SQLServerBuilder {
+	sb.custom.QuoteIdentifiers = quote
+	return sb
+}
+
+// OutputInserted sets OUTPUT INSERTED.cols of INSERT / UPDATE (no cols: INSERTED.*)
+func (sb *SQLServerBuilder) OutputInserted(cols ...string) *SQLServerBuilder {
+	sb.custom.OutputInserted = outputCols(cols)
+	return sb
+}
+
+// OutputDeleted sets OUTPUT DELETED.cols of UPDATE / DELETE (no cols: DELETED.*)
+func (sb *SQLServerBuilder) OutputDeleted(cols ...string) *SQLServerBuilder {
+	sb.custom.OutputDeleted = outputCols(cols)
+	return sb
+}
+
+// Build constructs and returns SQLServerCustom configuration
+func (sb *SQLServerBuilder) Build() *SQLServerCustom {
+	return sb.custom
+}
+
+func outputCols(cols []string) []string {
+	if len(cols) == 0 {
+		return []string{"*"}
+	}
+	return append([]string(nil), cols...)
+}
+
+// ============================================================================
+// SQLServerCustom: SQL Server-Specific Configuration
+// ============================================================================
+
+// SQLServerCustom SQL Server database-specific configuration
+//
+// Notes:
+//   - Parameters are numbered as @p1, @p2 ...
+//   - Identifiers are bracket-quoted: name → [name], t.name → [t].[name]
+//   - Pagination: TOP n (first page), ORDER BY ... OFFSET x ROWS FETCH NEXT y ROWS ONLY (others)
+//   - OFFSET ... FETCH requires ORDER BY, without Sort() SqlOfPage() panics with DialectError
+//
+// Example:
+//
+//	built := xb.Of("users").
+//	    Custom(xb.DefaultSQLServerCustom()).
+//	    Gt("age", 18).
+//	    Sort("id", xb.ASC).
+//	    Paged(func(pb *xb.PageBuilder) {
+//	        pb.Page(3).Rows(10)
+//	    }).
+//	    Build()
+//
+//	countSql, dataSql, args, _ := built.SqlOfPage()
+//	// SELECT COUNT(*) FROM [users] WHERE [age] > @p1
+//	// SELECT * FROM [users] WHERE [age] > @p1 ORDER BY [id] ASC OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY
+type SQLServerCustom struct {
+	// QuoteIdentifiers bracket-quotes plain identifiers
+	QuoteIdentifiers bool
+
+	// OutputInserted columns of OUTPUT INSERTED.col (INSERT / UPDATE)
+	OutputInserted []string
+
+	// OutputDeleted columns of OUTPUT DELETED.col (UPDATE / DELETE)
+	OutputDeleted []string
+}
+
+// newSQLServerCustom internal function: creates default SQL Server Custom
+func newSQLServerCustom() *SQLServerCustom {
+	return &SQLServerCustom{
+		QuoteIdentifiers: true,
+	}
+}
+
+// ============================================================================
+// Implements Custom Interface
+// ============================================================================
+
+// Generate implements Custom interface
+//
+// Returns:
+//   - interface{}: *SQLResult (@pN parameters)
+//   - error: *DialectError if OFFSET ... FETCH has no ORDER BY
+func (c *SQLServerCustom) Generate(built *Built) (interface{}, error) {
+	if c.QuoteIdentifiers {
+		built = built.withQuotedIdents("[", "]")
+	}
+	built = built.withDialect(dialectSQLServer).withLikeDialect(LikeEscapeChar, false)
+	if err := built.fullTextError("sqlserver"); err != nil {
+		return nil, err
+	}
+	if err := built.jsonPathError("sqlserver"); err != nil {
+		return nil, err
+	}
+	if err := built.arrayError("sqlserver"); err != nil {
+		return nil, err
+	}
+	if err := built.lockError("sqlserver", ForUpdate, ForShare); err != nil {
+		return nil, err
+	}
+	built = built.withLockHint()
+
+	vs := []interface{}{}
+
+	// ⭐ Insert scenario: INSERT INTO t (...) OUTPUT INSERTED.* VALUES (...)
+	if built.Inserts != nil {
+		sql := built.SqlInsert(&vs)
+		if output := c.outputClause(true, false); output != "" {
+			sql = strings.Replace(sql, VALUES, output+VALUES, 1)
+		}
+		return &SQLResult{SQL: built.numberPlaceholders(sql, "@p"), Args: vs}, nil
+	}
+
+	// ⭐ Update scenario: UPDATE t SET ... OUTPUT INSERTED.* WHERE ...
+	if built.Updates != nil {
+		sb := strings.Builder{}
+		sb.WriteString(UPDATE)
+		if built.isJoinedUpdate() {
+			// ⭐ UPDATE o SET ... FROM t o INNER JOIN ...
+			sb.WriteString(built.updateTarget())
+			built.toUpdateSql(&sb, &vs)
+			if output := c.outputClause(true, true); output != "" {
+				sb.WriteString(strings.TrimPrefix(output, SPACE))
+				sb.WriteString(SPACE)
+			}
+			sb.WriteString(FROM)
+			built.toFromSql(&vs, &sb)
+		} else {
+			built.toFromSql(&vs, &sb)
+			built.toUpdateSql(&sb, &vs)
+			sb.WriteString(strings.TrimPrefix(c.outputClause(true, true), SPACE))
+		}
+		built.sqlWhere(&sb)
+		built.toCondSql(built.Conds, &sb, &vs, built.filterLast)
+		return &SQLResult{SQL: built.numberPlaceholders(sb.String(), "@p"), Args: vs}, nil
+	}
+
+	// ⭐ Delete scenario: DELETE FROM t OUTPUT DELETED.* WHERE ...
+	if built.Delete {
+		if err := built.joinedDeleteError("sqlserver"); err != nil {
+			return nil, err
+		}
+		sb := strings.Builder{}
+		sb.WriteString(DELETE)
+		sb.WriteString(FROM)
+		built.toFromSql(&vs, &sb)
+		sb.WriteString(c.outputClause(false, true))
+		built.sqlWhere(&sb)
+		built.toCondSql(built.Conds, &sb, &vs, built.filterLast)
+		return &SQLResult{SQL: built.numberPlaceholders(sb.String(), "@p"), Args: vs}, nil
+	}
+
+	// ⭐ Select scenario
+	offset, rows := built.pageRange()
+	var toPageSql func(bp *strings.Builder)
+	if rows > 0 || offset > 0 {
+		if offset == 0 && len(built.Unions) == 0 {
+			cloned := *built
+			cloned.selectHint = "TOP " + strconv.Itoa(rows)
+			built = &cloned
+		} else {
+			if len(built.Sorts) == 0 {
+				return nil, &DialectError{
+					Dialect: "sqlserver",
+					Reason:  "OFFSET ... FETCH requires ORDER BY, call Sort()",
+				}
+			}
+			toPageSql = func(bp *strings.Builder) {
+				c.toOffsetFetchSql(bp, offset, rows)
+			}
+		}
+	}
+
+	km := make(map[string]string)
+	sql, kmp := built.sqlDataOf(&vs, km, toPageSql)
+	return &SQLResult{
+		SQL:      built.numberPlaceholders(sql, "@p"),
+		CountSQL: built.numberPlaceholders(built.SqlCount(), "@p"),
+		Args:     vs,
+		Meta:     kmp,
+	}, nil
+}
+
+// ============================================================================
+// Internal Implementation
+// ============================================================================
+
+// toOffsetFetchSql writes OFFSET x ROWS FETCH NEXT y ROWS ONLY
+func (c *SQLServerCustom) toOffsetFetchSql(bp *strings.Builder, offset int, rows int) {
+	bp.WriteString(" OFFSET ")
+	bp.WriteString(strconv.Itoa(offset))
+	bp.WriteString(" ROWS")
+	if rows > 0 {
+		bp.WriteString(" FETCH NEXT ")
+		bp.WriteString(strconv.Itoa(rows))
+		bp.WriteString(" ROWS ONLY")
+	}
+}
+
+// outputClause builds OUTPUT INSERTED.col, DELETED.col
+func (c *SQLServerCustom) outputClause(inserted bool, deleted bool) string {
+	cols := []string{}
+	if inserted {
+		for _, col := range c.OutputInserted {
+			cols = append(cols, "INSERTED."+col)
+		}
+	}
+	if deleted {
+		for _, col := range c.OutputDeleted {
+			cols = append(cols, "DELETED."+col)
+		}
+	}
+	if len(cols) == 0 {
+		return ""
+	}
+	return " OUTPUT " + strings.Join(cols, ", ")
+}
+
+// ============================================================================
+// Default SQL Server Custom (Global Singleton)
+// ============================================================================
+
+// defaultSQLServerCustom default SQL Server Custom instance
+var defaultSQLServerCustom = newSQLServerCustom()
+
+// DefaultSQLServerCustom gets default SQL Server Custom (singleton)
+//
+// Example:
+//
+//	xb.CustomGlobal(xb.DefaultSQLServerCustom())
+func DefaultSQLServerCustom() *SQLServerCustom {
+	return defaultSQLServerCustom
+}
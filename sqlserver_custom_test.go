@@ -0,0 +1,211 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSQLServerCustom_QuoteAndNumber(t *testing.T) {
+	built := Of("users").
+		Custom(DefaultSQLServerCustom()).
+		Select("id", "name").
+		Eq("status", "active").
+		Gt("age", 18).
+		In("role", "admin", "dev").
+		Build()
+
+	sql, args, _ := built.SqlOfSelect()
+
+	want := "SELECT [id], [name] FROM [users] WHERE [status] = @p1 AND [age] > @p2 AND [role] IN (@p3, @p4)"
+	if sql != want {
+		t.Fatalf("got:  %s\nwant: %s", sql, want)
+	}
+	if len(args) != 4 {
+		t.Errorf("expected 4 args, got %v", args)
+	}
+}
+
+func TestSQLServerCustom_TopOnFirstPage(t *testing.T) {
+	built := Of("users").
+		Custom(NewSQLServerBuilder().QuoteIdentifiers(false).Build()).
+		Gt("age", 18).
+		Paged(func(pb *PageBuilder) {
+			pb.Page(1).Rows(10)
+		}).
+		Build()
+
+	countSql, dataSql, _, _ := built.SqlOfPage()
+
+	if dataSql != "SELECT TOP 10 * FROM users WHERE age > @p1" {
+		t.Errorf("unexpected data SQL: %s", dataSql)
+	}
+	if countSql != "SELECT COUNT(*) FROM users WHERE age > @p1" {
+		t.Errorf("unexpected count SQL: %s", countSql)
+	}
+}
+
+func TestSQLServerCustom_DistinctTop(t *testing.T) {
+	sql, _, _ := Of("users").
+		Custom(NewSQLServerBuilder().QuoteIdentifiers(false).Build()).
+		Select("DISTINCT name", "age").
+		Gt("age", 18).
+		Limit(10).
+		Build().
+		SqlOfSelect()
+
+	if sql != "SELECT DISTINCT TOP 10 name, age FROM users WHERE age > @p1" {
+		t.Errorf("unexpected SQL: %s", sql)
+	}
+}
+
+func TestSQLServerCustom_WrappedCountWithoutTop(t *testing.T) {
+	built := Of("users").
+		Custom(DefaultSQLServerCustom()).
+		Select("status").
+		Gt("age", 18).
+		GroupBy("status").
+		Paged(func(pb *PageBuilder) {
+			pb.Page(1).Rows(10)
+		}).
+		Build()
+
+	countSql, dataSql, _, _ := built.SqlOfPage()
+
+	if dataSql != "SELECT TOP 10 [status] FROM [users] WHERE [age] > @p1 GROUP BY [status]" {
+		t.Errorf("unexpected data SQL: %s", dataSql)
+	}
+	want := "SELECT COUNT(*) FROM (SELECT [status] FROM [users] WHERE [age] > @p1 GROUP BY [status]) t"
+	if countSql != want {
+		t.Fatalf("got:  %s\nwant: %s", countSql, want)
+	}
+
+	countSql, _ = Of("users").
+		Custom(DefaultSQLServerCustom()).
+		Select("DISTINCT name").
+		Paged(func(pb *PageBuilder) {
+			pb.Page(1).Rows(10)
+		}).
+		Build().
+		SqlOfCount()
+	if countSql != "SELECT COUNT(*) FROM (SELECT DISTINCT name AS c0 FROM [users]) t" {
+		t.Errorf("unexpected count SQL: %s", countSql)
+	}
+}
+
+func TestSQLServerCustom_OffsetFetch(t *testing.T) {
+	built := Of("users").
+		Custom(DefaultSQLServerCustom()).
+		Gt("age", 18).
+		Sort("id", ASC).
+		Paged(func(pb *PageBuilder) {
+			pb.Page(3).Rows(10)
+		}).
+		Build()
+
+	_, dataSql, _, _ := built.SqlOfPage()
+
+	want := "SELECT * FROM [users] WHERE [age] > @p1 ORDER BY [id] ASC OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY"
+	if dataSql != want {
+		t.Fatalf("got:  %s\nwant: %s", dataSql, want)
+	}
+	if strings.Contains(dataSql, "LIMIT") {
+		t.Errorf("LIMIT must not be generated: %s", dataSql)
+	}
+}
+
+func TestSQLServerCustom_OffsetWithoutSort(t *testing.T) {
+	built := Of("users").
+		Custom(DefaultSQLServerCustom()).
+		Paged(func(pb *PageBuilder) {
+			pb.Page(2).Rows(10)
+		}).
+		Build()
+
+	_, err := DefaultSQLServerCustom().Generate(built)
+	if _, ok := err.(*DialectError); !ok {
+		t.Fatalf("expected *DialectError, got %v", err)
+	}
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("expected panic without Sort()")
+		}
+		if !strings.Contains(r.(string), "ORDER BY") {
+			t.Errorf("unexpected panic: %v", r)
+		}
+	}()
+	built.SqlOfPage()
+}
+
+func TestSQLServerCustom_OutputInserted(t *testing.T) {
+	custom := NewSQLServerBuilder().OutputInserted("id").Build()
+
+	built := Of("users").
+		Custom(custom).
+		Insert(func(ib *InsertBuilder) {
+			ib.Set("name", "Alice").Set("age", 18)
+		}).
+		Build()
+
+	sql, args := built.SqlOfInsert()
+
+	if !strings.HasPrefix(sql, "INSERT INTO [users] ([name], [age]) OUTPUT INSERTED.id VALUES") {
+		t.Errorf("unexpected insert SQL: %s", sql)
+	}
+	if !strings.Contains(sql, "@p1") || !strings.Contains(sql, "@p2") {
+		t.Errorf("insert SQL should be numbered: %s", sql)
+	}
+	if len(args) != 2 {
+		t.Errorf("expected 2 args, got %v", args)
+	}
+}
+
+func TestSQLServerCustom_OutputOnUpdateAndDelete(t *testing.T) {
+	custom := NewSQLServerBuilder().OutputInserted("name").OutputDeleted("name").Build()
+
+	built := Of("users").
+		Custom(custom).
+		Update(func(ub *UpdateBuilder) {
+			ub.Set("name", "Bob")
+		}).
+		Eq("id", 1).
+		Build()
+
+	sql, args := built.SqlOfUpdate()
+
+	if !strings.Contains(sql, "OUTPUT INSERTED.name, DELETED.name WHERE [id] = @p2") {
+		t.Errorf("unexpected update SQL: %s", sql)
+	}
+	if len(args) != 2 {
+		t.Errorf("expected 2 args, got %v", args)
+	}
+
+	built = Of("users").
+		Custom(NewSQLServerBuilder().OutputDeleted().Build()).
+		Eq("id", 1).
+		Build()
+
+	sql, _ = built.SqlOfDelete()
+
+	if sql != "DELETE FROM [users] OUTPUT DELETED.* WHERE [id] = @p1" {
+		t.Errorf("unexpected delete SQL: %s", sql)
+	}
+}
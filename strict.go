@@ -0,0 +1,116 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Strict only nil (and nil pointers) is treated as unset, 0 / "" / false are bound as args
+// Applies to Eq/Ne/Gt/Gte/Lt/Lte (also in And()/Or()/Having()) and UpdateBuilder.Set() called after it,
+// Filter(dto) keeps ignoring the zero fields of the DTO
+//
+// Example:
+//
+//	xb.Of("t_user").Strict().
+//	    Eq("age", 0).
+//	    Eq("nickname", "").
+//	    Eq("deleted", false).
+//	    Eq("email", nil). // ignored
+//	    Build()
+//	// SELECT * FROM t_user WHERE age = ? AND nickname = ? AND deleted = ?
+func (x *BuilderX) Strict() *BuilderX {
+	x.strict = true
+	return x
+}
+
+// EqZero k = ?, only nil is ignored (0 / "" / false are bound), see BuilderX.Strict()
+func (cb *CondBuilder) EqZero(k string, v interface{}) *CondBuilder {
+	return cb.doGLEStrict(EQ, k, v)
+}
+
+// NeZero k <> ?, only nil is ignored (0 / "" / false are bound), see BuilderX.Strict()
+func (cb *CondBuilder) NeZero(k string, v interface{}) *CondBuilder {
+	return cb.doGLEStrict(NE, k, v)
+}
+
+func (cb *CondBuilder) doGLEStrict(p string, k string, v interface{}) *CondBuilder {
+	v, ok := strictValueOf(v)
+	if !ok {
+		return cb
+	}
+	return cb.addBb(p, k, v)
+}
+
+// SetZero k = ?, only nil is ignored (0 / "" / false are set), see BuilderX.Strict()
+// Other values are converted as Set() does (JSON, array ...)
+func (ub *UpdateBuilder) SetZero(k string, v interface{}) *UpdateBuilder {
+	v, ok := strictValueOf(v)
+	if !ok {
+		return ub
+	}
+	if rv := reflect.ValueOf(v); !isStrictDeref(rv.Type()) || !rv.IsZero() {
+		return ub.set(k, v)
+	}
+	ub.bbs = append(ub.bbs, Bb{
+		Key:   k,
+		Value: v,
+	})
+	return ub
+}
+
+// strictValueOf dereferences pointers of basic types / time.Time, false if nil
+// time.Time is formatted as the non-strict conditions, uuid.UUID as string
+func strictValueOf(v interface{}) (interface{}, bool) {
+	if v == nil {
+		return nil, false
+	}
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil, false
+		}
+		if !isStrictDeref(rv.Type().Elem()) {
+			break
+		}
+		rv = rv.Elem()
+	}
+	switch x := rv.Interface().(type) {
+	case time.Time:
+		return x.Format("2006-01-02 15:04:05"), true
+	case uuid.UUID:
+		return x.String(), true
+	}
+	return rv.Interface(), true
+}
+
+var uuidType = reflect.TypeOf(uuid.UUID{})
+
+func isStrictDeref(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Bool, reflect.String, reflect.Ptr,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return t == timeType || t == uuidType
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestStrict_BindsZeroValues(t *testing.T) {
+	var nilAge *int
+	zero := 0
+	empty := ""
+	at := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	built := Of("t_user").Strict().
+		Eq("age", 0).
+		Eq("nickname", "").
+		Eq("deleted", false).
+		Eq("email", nil).
+		Eq("level", nilAge).
+		Gte("score", &zero).
+		Ne("remark", &empty).
+		Lt("created_at", &at).
+		Or(func(cb *CondBuilder) {
+			cb.Eq("a", 0).OR().Eq("b", "")
+		}).
+		Build()
+
+	sql, args, _ := built.SqlOfSelect()
+	want := "SELECT * FROM t_user WHERE age = ? AND nickname = ? AND deleted = ? AND score >= ? AND remark <> ? AND created_at < ? AND (a = ? OR b = ?)"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+	wantArgs := []interface{}{0, "", false, 0, "", "2025-01-02 03:04:05", 0, ""}
+	if !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("args: %v\nwant: %v", args, wantArgs)
+	}
+}
+
+func TestStrict_PerCallAndDefault(t *testing.T) {
+	sql, args, _ := Of("t_user").
+		EqZero("age", 0).
+		NeZero("name", "").
+		EqZero("email", nil).
+		Eq("status", 0).
+		Build().
+		SqlOfSelect()
+	if sql != "SELECT * FROM t_user WHERE age = ? AND name <> ?" {
+		t.Errorf("unexpected: %s", sql)
+	}
+	if !reflect.DeepEqual(args, []interface{}{0, ""}) {
+		t.Errorf("args: %v", args)
+	}
+}
+
+func TestStrict_UpdateSet(t *testing.T) {
+	built := Of("t_user").Strict().
+		Update(func(ub *UpdateBuilder) {
+			ub.Set("age", 0).Set("nickname", "").Set("avatar", nil)
+		}).
+		Eq("id", 7).
+		Build()
+	sql, args := built.SqlOfUpdate()
+	if sql != "UPDATE t_user SET age = ?, nickname = ?  WHERE id = ?" {
+		t.Errorf("unexpected: %s", sql)
+	}
+	if !reflect.DeepEqual(args, []interface{}{0, "", 7}) {
+		t.Errorf("args: %v", args)
+	}
+
+	sql, args = Of("t_user").
+		Update(func(ub *UpdateBuilder) {
+			ub.SetZero("age", 0).Set("nickname", "")
+		}).
+		Eq("id", 7).
+		Build().
+		SqlOfUpdate()
+	if sql != "UPDATE t_user SET age = ?  WHERE id = ?" || !reflect.DeepEqual(args, []interface{}{0, 7}) {
+		t.Errorf("unexpected: %s %v", sql, args)
+	}
+}
+
+func TestStrict_UpdateSetConvertsAsSet(t *testing.T) {
+	type profile struct {
+		City string
+	}
+	id := uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	built := Of("t_user").Strict().
+		Update(func(ub *UpdateBuilder) {
+			ub.Set("tags", []interface{}{"a", "b"}).
+				Set("friend_ids", []uuid.UUID{id}).
+				Set("roles", []string(nil)).
+				Set("profile", profile{City: "Paris"}).
+				Set("score", 0)
+		}).
+		Eq("id", 7).
+		Build()
+	sql, args := built.SqlOfUpdate()
+	if sql != "UPDATE t_user SET tags = ?, friend_ids = ?, profile = ?, score = ?  WHERE id = ?" {
+		t.Errorf("unexpected: %s", sql)
+	}
+	want := []interface{}{[]string{"a", "b"}, []string{id.String()}, profile{City: "Paris"}, 0, 7}
+	if !reflect.DeepEqual(args, want) {
+		t.Errorf("args: %v\nwant: %v", args, want)
+	}
+}
+
+func TestStrict_FilterIgnoresZeroFields(t *testing.T) {
+	type query struct {
+		Status int    `xb:"eq,status"`
+		Name   string `xb:"like,name"`
+	}
+	sql, args, _ := Of("t_user").Strict().
+		Filter(&query{}).
+		Eq("age", 0).
+		Build().
+		SqlOfSelect()
+	if sql != "SELECT * FROM t_user WHERE age = ?" || !reflect.DeepEqual(args, []interface{}{0}) {
+		t.Errorf("unexpected: %s %v", sql, args)
+	}
+}
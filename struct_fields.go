@@ -0,0 +1,96 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"reflect"
+
+	. "github.com/fndome/xb/internal"
+)
+
+// structField column of a `db:"name,omitempty,pk"` tagged field
+type structField = DbField
+
+// structFieldsOf gets the db tagged fields of the struct type (cached per type)
+func structFieldsOf(t reflect.Type) []structField {
+	return DbFieldsOf(t)
+}
+
+// structValueOf *T or T → reflect.Value of T, fn is the caller in panic message, e.g. Struct(po)
+func structValueOf(po interface{}, fn string) reflect.Value {
+	rv := reflect.ValueOf(po)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			panic(fn + ", can not be nil")
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		panic(fn + ", must be a struct or a pointer to struct, got " + rv.Kind().String())
+	}
+	return rv
+}
+
+// StructOption option of InsertBuilder.Struct() / UpdateBuilder.Struct()
+type StructOption func(opts *structOptions)
+
+type structOptions struct {
+	columns []string
+	omits   []string
+}
+
+// Columns only the columns (pk is still the WHERE of UpdateBuilder.Struct())
+func Columns(cols ...string) StructOption {
+	return func(opts *structOptions) {
+		opts.columns = append(opts.columns, cols...)
+	}
+}
+
+// OmitColumns excludes the columns
+func OmitColumns(cols ...string) StructOption {
+	return func(opts *structOptions) {
+		opts.omits = append(opts.omits, cols...)
+	}
+}
+
+func (opts *structOptions) skip(column string) bool {
+	if len(opts.columns) > 0 && !hasKey(opts.columns, column) {
+		return true
+	}
+	return hasKey(opts.omits, column)
+}
+
+// eachStructField calls fn with column and value of the db tagged fields
+// keepPk: pk fields are not filtered by Columns() / OmitColumns()
+func eachStructField(po interface{}, opts []StructOption, keepPk bool, fn func(sf structField, v interface{})) {
+	rv := structValueOf(po, "Struct(po)")
+	options := structOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	for _, sf := range structFieldsOf(rv.Type()) {
+		fv := rv.FieldByIndex(sf.Index)
+		if sf.OmitEmpty && fv.IsZero() {
+			continue
+		}
+		if !(keepPk && sf.Pk) && options.skip(sf.Column) {
+			continue
+		}
+		fn(sf, fv.Interface())
+	}
+}
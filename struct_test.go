@@ -0,0 +1,124 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type structBase struct {
+	CreatedAt time.Time `db:"created_at,omitempty"`
+}
+
+type structCat struct {
+	structBase
+	ID       uint64   `db:"id,pk"`
+	Name     string   `db:"name"`
+	Age      *uint    `db:"age"`
+	Price    *float64 `db:"price"`
+	Memo     string   `db:"-"`
+	internal string
+	NoTag    string
+}
+
+func (*structCat) TableName() string {
+	return "t_cat"
+}
+
+func TestInsertBuilder_Struct(t *testing.T) {
+	cat := structCat{Name: "Tom", Age: Uint(3), Memo: "ignored", NoTag: "ignored"}
+
+	built := Of(&cat).
+		Insert(func(ib *InsertBuilder) {
+			ib.Struct(&cat)
+		}).
+		Build()
+
+	sql, args := built.SqlOfInsert()
+
+	if !strings.HasPrefix(sql, "INSERT INTO t_cat (name, age)") {
+		t.Errorf("zero pk, nil pointer, omitempty time and - should be skipped: %s", sql)
+	}
+	if !reflect.DeepEqual(args, []interface{}{"Tom", uint(3)}) {
+		t.Errorf("unexpected args: %v", args)
+	}
+}
+
+func TestInsertBuilder_StructOptions(t *testing.T) {
+	at := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	cat := structCat{structBase: structBase{CreatedAt: at}, ID: 9, Name: "Tom", Price: Float64(1.5)}
+
+	built := Of(&cat).
+		Insert(func(ib *InsertBuilder) {
+			ib.Struct(cat, OmitColumns("price"))
+		}).
+		Build()
+
+	sql, _ := built.SqlOfInsert()
+	if !strings.HasPrefix(sql, "INSERT INTO t_cat (created_at, id, name)") {
+		t.Errorf("unexpected SQL: %s", sql)
+	}
+}
+
+func TestUpdateBuilder_StructPkAsWhere(t *testing.T) {
+	cat := structCat{ID: 7, Name: "Tom", Price: Float64(9.9)}
+
+	built := Of(&cat).
+		Update(func(ub *UpdateBuilder) {
+			ub.Struct(&cat, Columns("name"))
+		}).
+		Build()
+
+	sql, args := built.SqlOfUpdate()
+
+	if sql != "UPDATE t_cat SET name = ?  WHERE id = ?" {
+		t.Errorf("unexpected SQL: %s", sql)
+	}
+	if !reflect.DeepEqual(args, []interface{}{"Tom", uint64(7)}) {
+		t.Errorf("unexpected args: %v", args)
+	}
+}
+
+func TestUpdateBuilder_StructZeroPkPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic with zero pk")
+		}
+	}()
+
+	cat := structCat{Name: "Tom"}
+	Of(&cat).Update(func(ub *UpdateBuilder) {
+		ub.Struct(&cat)
+	})
+}
+
+func TestStructFields_Cached(t *testing.T) {
+	typ := reflect.TypeOf(structCat{})
+	first := structFieldsOf(typ)
+	second := structFieldsOf(typ)
+
+	if len(first) != 5 {
+		t.Fatalf("expected 5 tagged fields, got %d", len(first))
+	}
+	if &first[0] != &second[0] {
+		t.Error("fields should be cached per type")
+	}
+}
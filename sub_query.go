@@ -0,0 +1,77 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+// Exists EXISTS (subquery), skipped if the subquery has no table
+// Available in WHERE, And()/Or(), Having() and ON of joins, the args of the subquery are bound in place
+//
+// Example:
+//
+//	xb.Of("t_user u").
+//	    Exists(func(sb *xb.BuilderX) {
+//	        sb.From("t_order o").Select("1").X("o.user_id = u.id").Eq("o.status", "paid")
+//	    }).
+//	    Build()
+//	// SELECT * FROM t_user u WHERE EXISTS (SELECT 1 FROM t_order o WHERE o.user_id = u.id AND o.status = ?)
+func (cb *CondBuilder) Exists(f func(sb *BuilderX)) *CondBuilder {
+	return cb.doSub("EXISTS ?", f)
+}
+
+// NotExists NOT EXISTS (subquery), skipped if the subquery has no table
+func (cb *CondBuilder) NotExists(f func(sb *BuilderX)) *CondBuilder {
+	return cb.doSub("NOT EXISTS ?", f)
+}
+
+// InSub k IN (subquery), skipped if the subquery has no table
+//
+// Example:
+//
+//	xb.Of("t_order").
+//	    InSub("user_id", func(sb *xb.BuilderX) {
+//	        sb.From("t_vip").Select("user_id").Gte("level", 3)
+//	    }).
+//	    Build()
+//	// SELECT * FROM t_order WHERE user_id IN (SELECT user_id FROM t_vip WHERE level >= ?)
+func (cb *CondBuilder) InSub(k string, f func(sb *BuilderX)) *CondBuilder {
+	if k == "" {
+		panic("InSub(k, f), k can not be blank")
+	}
+	return cb.doSub(k+" IN ?", f)
+}
+
+// NotInSub k NOT IN (subquery), skipped if the subquery has no table
+func (cb *CondBuilder) NotInSub(k string, f func(sb *BuilderX)) *CondBuilder {
+	if k == "" {
+		panic("NotInSub(k, f), k can not be blank")
+	}
+	return cb.doSub(k+" NOT IN ?", f)
+}
+
+func (cb *CondBuilder) doSub(key string, f func(sb *BuilderX)) *CondBuilder {
+	sb := X()
+	f(sb)
+	if sb.orFromSql == "" && len(sb.sxs) == 0 {
+		return cb
+	}
+	cb.bbs = append(cb.bbs, Bb{
+		Op:    SUB,
+		Key:   key,
+		Value: sb,
+	})
+	return cb
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExists_ArgsInOrder(t *testing.T) {
+	built := Of("t_user u").
+		Eq("u.status", 1).
+		Exists(func(sb *BuilderX) {
+			sb.From("t_order o").Select("1").X("o.user_id = u.id").Eq("o.state", "paid")
+		}).
+		NotInSub("u.id", func(sb *BuilderX) {
+			sb.From("t_blacklist").Select("user_id").Gt("level", 2)
+		}).
+		Gt("u.age", 18).
+		Build()
+
+	sql, args, _ := built.SqlOfSelect()
+	want := "SELECT * FROM t_user u WHERE u.status = ? AND EXISTS (SELECT 1 FROM t_order o WHERE o.user_id = u.id AND o.state = ?) " +
+		"AND u.id NOT IN (SELECT user_id FROM t_blacklist WHERE level > ?) AND u.age > ?"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+	if !reflect.DeepEqual(args, []interface{}{1, "paid", 2, 18}) {
+		t.Errorf("args: %v", args)
+	}
+}
+
+func TestInSub_OnAndHaving(t *testing.T) {
+	built := Of("t_order").As("o").
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(INNER).Of("t_user").As("u").On("u.id = o.user_id").
+				Cond(func(on *ON) {
+					on.InSub("u.id", func(sb *BuilderX) {
+						sb.From("t_vip").Select("user_id").Eq("region", "eu")
+					})
+				})
+		}).
+		Select("o.user_id", "SUM(o.amount) AS total").
+		Gt("o.amount", 10).
+		GroupBy("o.user_id").
+		Having(func(cb *CondBuilderX) {
+			cb.NotExists(func(sb *BuilderX) {
+				sb.From("t_refund r").Select("1").X("r.user_id = o.user_id").Gt("r.amount", 100)
+			})
+		}).
+		Custom(DefaultPostgresCustom()).
+		Build()
+
+	sql, args, _ := built.SqlOfSelect()
+	want := "SELECT o.user_id AS c0, SUM(o.amount) AS total FROM t_order o INNER JOIN t_user u ON u.id = o.user_id " +
+		"AND u.id IN (SELECT user_id FROM t_vip WHERE region = $1) WHERE o.amount > $2 GROUP BY o.user_id " +
+		"HAVING NOT EXISTS (SELECT 1 FROM t_refund r WHERE r.user_id = o.user_id AND r.amount > $3)"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+	if !reflect.DeepEqual(args, []interface{}{"eu", 10, 100}) {
+		t.Errorf("args: %v", args)
+	}
+}
+
+func TestExists_SkippedWithoutTable(t *testing.T) {
+	built := Of("t_user").
+		Exists(func(sb *BuilderX) {
+			sb.Select("1").Eq("status", 1)
+		}).
+		InSub("id", func(sb *BuilderX) {}).
+		Eq("name", "Tom").
+		Build()
+
+	sql, args, _ := built.SqlOfSelect()
+	if sql != "SELECT * FROM t_user WHERE name = ?" || len(args) != 1 {
+		t.Errorf("unexpected: %s %v", sql, args)
+	}
+}
@@ -41,13 +41,13 @@ func (built *Built) toFromSqlBySql(bp *strings.Builder) bool {
 func (built *Built) toFromSqlByBuilder(vs *[]interface{}, sx *FromX, bp *strings.Builder) {
 	if sx.join != nil { //Join
 		bp.WriteString(SPACE)
-		bp.WriteString(sx.join.join)
+		bp.WriteString(built.joinOf(sx.join.join))
 		bp.WriteString(SPACE)
 	}
 	if sx.tableName != "" {
 		bp.WriteString(sx.tableName)
 	} else if sx.sub != nil {
-		dataSql, _ := sx.sub.Build().SqlData(vs, nil)
+		dataSql, _ := built.inheritDialect(sx.sub.Build()).SqlData(vs, nil)
 		bp.WriteString(BEGIN_SUB)
 		bp.WriteString(dataSql)
 		bp.WriteString(END_SUB)
@@ -56,6 +56,10 @@ func (built *Built) toFromSqlByBuilder(vs *[]interface{}, sx *FromX, bp *strings
 		bp.WriteString(SPACE)
 		bp.WriteString(sx.alia)
 	}
+	if sx.tableName != "" {
+		built.toIndexHintSql(bp, sx.indexHints)
+		built.toLockHintSql(bp, sx.tableName, sx.alia, sx == built.Fxs[0])
+	}
 	if sx.join != nil && sx.join.on != nil { //ON
 
 		if sx.join.on.orUsingKey != "" {
@@ -68,3 +72,64 @@ func (built *Built) toFromSqlByBuilder(vs *[]interface{}, sx *FromX, bp *strings
 		}
 	}
 }
+
+// toUsingSql writes the tables after the first one of FromX(), for DELETE ... USING / UPDATE ... FROM,
+// the ON of the first of them goes to WHERE, see toUsingWhereSql()
+func (built *Built) toUsingSql(vs *[]interface{}, bp *strings.Builder, dialect string, clause string) ([]Bb, error) {
+	var ons []Bb
+	for i, sx := range built.Fxs[1:] {
+		if i > 0 {
+			built.toFromSqlByBuilder(vs, sx, bp)
+			continue
+		}
+		if sx.join != nil {
+			switch built.joinOf(sx.join.join) {
+			case inner_join, cross_join, NON_JOIN():
+			default:
+				return nil, &DialectError{Dialect: dialect, Reason: clause + " requires INNER JOIN of the first joined table, not " + sx.join.join}
+			}
+			if sx.join.on != nil {
+				if sx.join.on.orUsingKey != "" {
+					return nil, &DialectError{Dialect: dialect, Reason: clause + " doesn't support JOIN ... USING (key), call On()"}
+				}
+				ons = sx.join.on.bbs
+			}
+		}
+		first := *sx
+		first.join = nil
+		built.toFromSqlByBuilder(vs, &first, bp)
+	}
+	return ons, nil
+}
+
+// toUsingWhereSql writes WHERE <ON of toUsingSql()> AND <conditions>, each side in () if it has OR
+func (built *Built) toUsingWhereSql(ons []Bb, vs *[]interface{}, bp *strings.Builder) {
+	onB := strings.Builder{}
+	built.toCondSql(ons, &onB, vs, nil)
+	condB := strings.Builder{}
+	built.toCondSql(built.Conds, &condB, vs, built.filterLast)
+	where, cond := onB.String(), condB.String()
+	if where != "" && cond != "" {
+		if built.hasOR(ons) {
+			where = BEGIN_SUB + where + END_SUB
+		}
+		if built.hasOR(built.Conds) {
+			cond = BEGIN_SUB + cond + END_SUB
+		}
+		where += AND_SCRIPT
+	}
+	where += cond
+	if where != "" {
+		bp.WriteString(WHERE)
+		bp.WriteString(where)
+	}
+}
+
+func (built *Built) hasOR(bbs []Bb) bool {
+	for _, bb := range bbs {
+		if built.isOR(bb) {
+			return true
+		}
+	}
+	return false
+}
@@ -40,6 +40,9 @@ import (
 //	sql := built.SqlInsert(&vs)
 //	// INSERT INTO users (name, age) VALUES (?, ?)
 func (built *Built) SqlInsert(vs *[]interface{}) string {
+	if len(built.InsertRows) > 0 {
+		return built.sqlInsertRows(vs)
+	}
 
 	bp := strings.Builder{}
 	bp.Grow(128) // Pre-allocate 128 bytes, INSERT statements are usually not very long
@@ -70,3 +73,58 @@ func (built *Built) SqlInsert(vs *[]interface{}) string {
 
 	return bp.String()
 }
+
+// sqlInsertRows generates batch INSERT SQL of InsertRows()
+// INSERT INTO users (name, age) VALUES (?, ?), (?, NULL)
+func (built *Built) sqlInsertRows(vs *[]interface{}) string {
+	cols := *built.Inserts
+	fill := built.insertFill
+	if fill == "" {
+		fill = "NULL"
+	}
+
+	bp := strings.Builder{}
+	bp.Grow(64 + len(built.InsertRows)*len(cols)*4)
+	bp.WriteString(INSERT)
+	bp.WriteString(built.OrFromSql)
+	bp.WriteString(SPACE)
+	bp.WriteString(BEGIN_SUB)
+	for i, col := range cols {
+		if i > 0 {
+			bp.WriteString(COMMA)
+		}
+		bp.WriteString(col.Key)
+	}
+	bp.WriteString(END_SUB)
+	bp.WriteString(VALUES)
+
+	for r, row := range built.InsertRows {
+		if r > 0 {
+			bp.WriteString(COMMA)
+		}
+		bp.WriteString(BEGIN_SUB)
+		for i, col := range cols {
+			if i > 0 {
+				bp.WriteString(COMMA)
+			}
+			if bb, ok := rowCell(row, col.Key); ok {
+				bp.WriteString(PLACE_HOLDER_MARK)
+				*vs = append(*vs, bb.Value)
+			} else {
+				bp.WriteString(fill)
+			}
+		}
+		bp.WriteString(END_SUB)
+	}
+
+	return bp.String()
+}
+
+func rowCell(row []Bb, key string) (Bb, bool) {
+	for _, bb := range row {
+		if bb.Key == key {
+			return bb, true
+		}
+	}
+	return Bb{}, false
+}
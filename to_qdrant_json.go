@@ -81,15 +81,24 @@ type QdrantFilter struct {
 
 // QdrantCondition Qdrant condition
 type QdrantCondition struct {
-	Key   string                `json:"key,omitempty"`
-	Match *QdrantMatchCondition `json:"match,omitempty"`
-	Range *QdrantRangeCondition `json:"range,omitempty"`
+	Key     string                `json:"key,omitempty"`
+	Match   *QdrantMatchCondition `json:"match,omitempty"`
+	Range   *QdrantRangeCondition `json:"range,omitempty"`
+	Should  []QdrantCondition     `json:"should,omitempty"`   // ⭐ Nested filter, Match() of multiple cols
+	Must    []QdrantCondition     `json:"must,omitempty"`     // ⭐ Nested filter, JsonPath().Contains() / ArrayContains() of slices
+	IsEmpty *QdrantIsEmpty        `json:"is_empty,omitempty"` // ⭐ JsonPath().HasKey() in must_not
+}
+
+// QdrantIsEmpty is_empty condition of a payload key
+type QdrantIsEmpty struct {
+	Key string `json:"key"`
 }
 
 // QdrantMatchCondition Qdrant exact match condition
 type QdrantMatchCondition struct {
 	Value interface{}   `json:"value,omitempty"`
 	Any   []interface{} `json:"any,omitempty"`
+	Text  string        `json:"text,omitempty"` // ⭐ Full-text match of Like() / ILike() / NotLike()
 }
 
 // QdrantRangeCondition Qdrant range condition
@@ -568,7 +577,11 @@ func buildQdrantFilter(bbs []Bb) (*QdrantFilter, error) {
 		}
 
 		if cond != nil {
-			filter.Must = append(filter.Must, *cond)
+			if bb.Op == NOT_LIKE || cond.IsEmpty != nil {
+				filter.MustNot = append(filter.MustNot, *cond)
+			} else {
+				filter.Must = append(filter.Must, *cond)
+			}
 		}
 	}
 
@@ -605,19 +618,12 @@ func bbToQdrantCondition(bb Bb) (*QdrantCondition, error) {
 		// Simplified handling here, caller needs to handle at upper level
 		return nil, fmt.Errorf("NE not directly supported, use must_not")
 
-	case IN:
+	case IN, IN_ANY:
 		// IN converts to match.any
-		// Note: IN's value is *[]string
+		// Note: IN's value is []interface{} (typed values, nil/zero filtered)
 		var anyValues []interface{}
 
 		switch v := bb.Value.(type) {
-		case *[]string:
-			if v == nil {
-				return nil, nil
-			}
-			for _, s := range *v {
-				anyValues = append(anyValues, s)
-			}
 		case []interface{}:
 			anyValues = v
 		case []string:
@@ -687,9 +693,89 @@ func bbToQdrantCondition(bb Bb) (*QdrantCondition, error) {
 			},
 		}, nil
 
-	case LIKE:
-		// Qdrant doesn't natively support LIKE, ignore
-		return nil, fmt.Errorf("LIKE not supported in Qdrant")
+	case LIKE, ILIKE, NOT_LIKE:
+		// ⭐ %text% / text% / %text → match.text (full-text index required), other patterns are ignored
+		pattern, _ := bb.Value.(string)
+		text, ok := likeText(pattern)
+		if !ok {
+			return nil, fmt.Errorf("LIKE pattern %q not supported in Qdrant", pattern)
+		}
+		return &QdrantCondition{
+			Key: bb.Key,
+			Match: &QdrantMatchCondition{
+				Text: text,
+			},
+		}, nil
+
+	case MATCH:
+		// ⭐ match.text of each col (full-text index required), any col matches
+		m := bb.Value.(fullTextMatch)
+		if len(m.cols) == 1 {
+			return &QdrantCondition{
+				Key:   m.cols[0],
+				Match: &QdrantMatchCondition{Text: m.query},
+			}, nil
+		}
+		cond := &QdrantCondition{}
+		for _, col := range m.cols {
+			cond.Should = append(cond.Should, QdrantCondition{
+				Key:   col,
+				Match: &QdrantMatchCondition{Text: m.query},
+			})
+		}
+		return cond, nil
+
+	case ARRAY_OVERLAPS:
+		// ⭐ Any element matches: match.any
+		return &QdrantCondition{
+			Key: bb.Key,
+			Match: &QdrantMatchCondition{
+				Any: expandSlice([]interface{}{bb.Value}),
+			},
+		}, nil
+
+	case ARRAY_CONTAINS:
+		// ⭐ All values match: match.value of each
+		cond := &QdrantCondition{}
+		for _, v := range expandSlice([]interface{}{bb.Value}) {
+			cond.Must = append(cond.Must, QdrantCondition{Key: bb.Key, Match: &QdrantMatchCondition{Value: v}})
+		}
+		if len(cond.Must) == 1 {
+			return &cond.Must[0], nil
+		}
+		return cond, nil
+
+	case ANY_EQ:
+		return &QdrantCondition{
+			Key: bb.Key,
+			Match: &QdrantMatchCondition{
+				Value: bb.Value,
+			},
+		}, nil
+
+	case JSON_PATH:
+		// ⭐ Nested payload key: payload.a.b, payload.items[].qty
+		jc := bb.Value.(jsonPathCond)
+		key := qdrantJsonKey(bb.Key, jc.segs)
+		switch jc.op {
+		case jsonHasKey:
+			return &QdrantCondition{IsEmpty: &QdrantIsEmpty{Key: key}}, nil
+		case jsonContains:
+			elems := jsonScalars(jc.value)
+			if len(elems) == 0 {
+				return nil, fmt.Errorf("JsonPath().Contains() of objects not supported in Qdrant")
+			}
+			if len(elems) == 1 {
+				return &QdrantCondition{Key: key, Match: &QdrantMatchCondition{Value: elems[0]}}, nil
+			}
+			cond := &QdrantCondition{}
+			for _, e := range elems {
+				cond.Must = append(cond.Must, QdrantCondition{Key: key, Match: &QdrantMatchCondition{Value: e}})
+			}
+			return cond, nil
+		default:
+			return bbToQdrantCondition(Bb{Op: jc.op, Key: key, Value: jc.value})
+		}
 
 	default:
 		// Unsupported operations, return nil (ignore)
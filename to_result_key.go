@@ -58,21 +58,45 @@ func buildResultKey(key string, km map[string]string) string {
 	return key
 }
 
-func (built *Built) toResultKeySql(bp *strings.Builder, km map[string]string) {
+func (built *Built) toResultKeySql(bp *strings.Builder, vs *[]interface{}, km map[string]string) {
 	if built.Updates != nil {
 		bp.WriteString(UPDATE)
 		return
 	}
 	bp.WriteString(SELECT)
-	if built.ResultKeys == nil {
+	built.toOptimizerHintSql(bp)
+	keys, selectArgs := built.resultKeysOf()
+	if built.selectHint != "" {
+		// ⭐ SELECT DISTINCT TOP 10 ... (DISTINCT must be before TOP)
+		if len(keys) > 0 {
+			if k := strings.TrimSpace(keys[0]); len(k) > len(DISTINCT_SCRIPT) &&
+				strings.EqualFold(k[:len(DISTINCT_SCRIPT)], DISTINCT_SCRIPT) && k[len(DISTINCT_SCRIPT)] == ' ' {
+				bp.WriteString(DISTINCT_SCRIPT)
+				bp.WriteString(SPACE)
+				keys = append([]string{strings.TrimSpace(k[len(DISTINCT_SCRIPT):])}, keys[1:]...)
+			}
+		}
+		bp.WriteString(built.selectHint)
+		bp.WriteString(SPACE)
+	}
+	var rankArgs []interface{}
+	if built.rankAlias != "" {
+		var rank string
+		rank, rankArgs = built.rankSql()
+		if len(keys) == 0 {
+			keys = []string{"*"}
+		}
+		keys = append(keys[:len(keys):len(keys)], rank+AS+built.rankAlias)
+	}
+	if keys == nil {
 		bp.WriteString(STAR)
 	} else {
-		length := len(built.ResultKeys)
+		length := len(keys)
 		if length == 0 {
 			bp.WriteString(STAR)
 		} else {
 			for i := 0; i < length; i++ {
-				key := (built.ResultKeys)[i]
+				key := keys[i]
 				key = buildResultKey(key, km)
 				bp.WriteString(key)
 				if i < length-1 {
@@ -81,12 +105,16 @@ func (built *Built) toResultKeySql(bp *strings.Builder, km map[string]string) {
 					bp.WriteString(SPACE)
 				}
 			}
+			if vs != nil {
+				*vs = append(*vs, selectArgs...)
+				*vs = append(*vs, rankArgs...)
+			}
 		}
 	}
 }
 
 func (built *Built) toResultKeySqlOfCount(bpCount *strings.Builder) {
-	if built.ResultKeys != nil && len(built.ResultKeys) > 0 {
+	if len(built.ResultKeys) > 0 && isCountableKey(built.ResultKeys[0]) {
 		bpCount.WriteString(COUNT_KEY_SCRIPT_LEFT)
 		bpCount.WriteString(built.ResultKeys[0])
 		bpCount.WriteString(END_SUB)
@@ -95,3 +123,16 @@ func (built *Built) toResultKeySqlOfCount(bpCount *strings.Builder) {
 		bpCount.WriteString(COUNT_BASE_SCRIPT)
 	}
 }
+
+// isCountableKey column or DISTINCT column(s) can be put in COUNT(...)
+// expression (CASE ... END, fn OVER (...)) or aliased key can't, COUNT(*) instead
+func isCountableKey(key string) bool {
+	k := strings.TrimSpace(key)
+	if strings.Contains(strings.ToUpper(k), AS) {
+		return false
+	}
+	if len(k) > len(DISTINCT_SCRIPT) && strings.EqualFold(k[:len(DISTINCT_SCRIPT)], DISTINCT_SCRIPT) {
+		return true
+	}
+	return !strings.ContainsAny(k, " (")
+}
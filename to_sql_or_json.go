@@ -28,6 +28,7 @@ import (
 type Built struct {
 	Delete     bool
 	Inserts    *[]Bb
+	InsertRows [][]Bb // ⭐ Rows of InsertRows(), Inserts holds the union of their columns
 	Updates    *[]Bb
 	ResultKeys []string
 	Conds      []Bb
@@ -51,6 +52,25 @@ type Built struct {
 	Alia        string
 	Withs       []WithClause
 	Unions      []UnionClause
+	Windows     []WindowClause // ⭐ Named windows: WINDOW w AS (...)
+
+	nested          bool          // ⭐ CTE / UNION branch: placeholders are numbered by the outer statement
+	dialect         string        // ⭐ Dialect of Custom.Generate(): mysql / postgres / ..., "" for the default SQL
+	selectCases     []selectCase  // ⭐ SelectCase() in ResultKeys
+	likeEscape      string        // ⭐ ESCAPE char of LIKE (SQLite / SQL Server / Oracle)
+	nativeILike     bool          // ⭐ ILIKE (PostgreSQL / ClickHouse), else LOWER(k) LIKE LOWER(?)
+	tsConfig        string        // ⭐ Text search config of PostgreSQL Match()
+	rankAlias       string        // ⭐ Alias of SelectRank()
+	lock            *lockClause   // ⭐ Lock(): FOR UPDATE ...
+	lockHint        bool          // ⭐ Lock() as table hints (SQL Server)
+	indexHints      []string      // ⭐ USE / FORCE / IGNORE INDEX of the table of Of() (MySQL)
+	optimizerHints  []string      // ⭐ /*+ ... */ after SELECT (MySQL)
+	commentKeys     []string      // ⭐ Allow-list of SqlComment(), nil if disabled
+	deleteTargets   []string      // ⭐ Tables of DELETE targets FROM ... JOIN (MySQL)
+	allowFullTable  bool          // ⭐ AllowFullTable(): DELETE / UPDATE without WHERE
+	selectHint      string        // ⭐ Written right after SELECT, e.g. TOP 10 (SQL Server)
+	insertMaxParams int           // ⭐ Max parameters of one statement of SqlOfInsertBatch()
+	insertFill      string        // ⭐ Missing cells of InsertRows(): NULL (default) or DEFAULT
 }
 
 // WithClause common table expression (CTE) definition
@@ -204,6 +224,13 @@ func (built *Built) toFromSql(vs *[]interface{}, bp *strings.Builder) {
 		}
 	} else {
 		bp.WriteString(built.OrFromSql)
+		if fields := strings.Fields(built.OrFromSql); len(fields) == 1 {
+			built.toIndexHintSql(bp, built.indexHints)
+			built.toLockHintSql(bp, fields[0], "", true)
+		} else if len(fields) == 2 {
+			built.toIndexHintSql(bp, built.indexHints)
+			built.toLockHintSql(bp, fields[0], fields[1], true)
+		}
 	}
 }
 
@@ -224,15 +251,35 @@ func (built *Built) toBb(bb Bb, bp *strings.Builder, vs *[]interface{}) {
 		bp.WriteString(bb.Op)
 		bp.WriteString(SPACE)
 		bp.WriteString(BEGIN_SUB)
-		arr := *(bb.Value.(*[]string))
+		arr := bb.Value.([]interface{})
 		inl := len(arr)
 		for i := 0; i < inl; i++ {
-			bp.WriteString(arr[i])
+			bp.WriteString(PLACE_HOLDER_MARK)
 			if i < inl-1 {
 				bp.WriteString(COMMA)
 			}
 		}
 		bp.WriteString(END_SUB)
+		if vs != nil {
+			*vs = append(*vs, arr...)
+		}
+	case IN_ANY, NIN_ALL:
+		bp.WriteString(bb.Key)
+		bp.WriteString(SPACE)
+		bp.WriteString(bb.Op)
+		bp.WriteString(BEGIN_SUB)
+		bp.WriteString(PLACE_HOLDER_MARK)
+		bp.WriteString(END_SUB)
+		if vs != nil {
+			*vs = append(*vs, toTypedSlice(bb.Value.([]interface{})))
+		}
+	case ANY_EQ:
+		bp.WriteString("? = ANY(")
+		bp.WriteString(bb.Key)
+		bp.WriteString(END_SUB)
+		if vs != nil {
+			*vs = append(*vs, bb.Value)
+		}
 	case IS_NULL, NON_NULL:
 		bp.WriteString(bb.Key)
 		bp.WriteString(SPACE)
@@ -244,9 +291,16 @@ func (built *Built) toBb(bb Bb, bp *strings.Builder, vs *[]interface{}) {
 		bp.WriteString(BEGIN_SUB)
 		built.toCondSql(bb.Subs, bp, vs, nil)
 		bp.WriteString(END_SUB)
+	case LIKE, NOT_LIKE, ILIKE:
+		built.toLikeSql(bb, bp, vs)
+	case MATCH:
+		built.toMatchSql(bb, bp, vs)
+	case JSON_PATH:
+		built.toJsonPathSql(bb, bp, vs)
 	case SUB:
 		var bx = *bb.Value.(*BuilderX)
-		ss, _ := bx.Build().SqlData(vs, nil)
+		sub := built.inheritDialect(bx.Build())
+		ss, _ := sub.SqlData(vs, nil)
 		ss = BEGIN_SUB + ss + END_SUB
 		ss = SPACE + ss
 		if bb.Key != "" {
@@ -348,7 +402,7 @@ func (built *Built) toHavingSqlOfCount(bp *strings.Builder) {
 	built.toHavingSql(nil, bp)
 }
 
-func (built *Built) toSortSql(bp *strings.Builder) {
+func (built *Built) toSortSql(bp *strings.Builder, vs *[]interface{}) {
 	if built.Sorts == nil {
 		return
 	}
@@ -359,7 +413,16 @@ func (built *Built) toSortSql(bp *strings.Builder) {
 	bp.WriteString(ORDER_BY)
 	for i := 0; i < length; i++ {
 		sort := built.Sorts[i]
+		if sort.rank {
+			sort.orderBy, sort.args = built.rankSql()
+		}
+		if sort.caseOf != nil {
+			sort.orderBy, sort.args = built.toCaseSql(sort.caseOf)
+		}
 		bp.WriteString(sort.orderBy)
+		if vs != nil && len(sort.args) > 0 {
+			*vs = append(*vs, sort.args...)
+		}
 		if sort.direction != "" {
 			bp.WriteString(SPACE)
 			bp.WriteString(sort.direction)
@@ -377,7 +440,7 @@ func (built *Built) toPageSql(bp *strings.Builder) {
 		if built.PageCondition.Rows >= 1 {
 			bp.WriteString(LIMIT)
 			bp.WriteString(strconv.Itoa(int(built.PageCondition.Rows)))
-			if built.PageCondition.Last < 1 {
+			if built.PageCondition.Last < 1 && !built.PageCondition.Keyset {
 				if built.PageCondition.Page < 1 {
 					built.PageCondition.Page = 1
 				}
@@ -426,10 +489,68 @@ func (built *Built) countBuilder() *strings.Builder {
 	return sbCount
 }
 
+// SqlOfCount generates COUNT SQL of pagination with its own args
+//
+// Notes:
+//   - The args of SqlOfPage() are the args of data SQL, the cursor of Paged().Last() / Cursor()
+//     is not in COUNT SQL, so executors should bind the args of SqlOfCount()
+//   - Returns "" if COUNT is not required (Rows <= 1 or IsTotalRowsIgnored)
+//
+// Example:
+//
+//	countSql, countArgs := built.SqlOfCount()
+//	db.QueryRow(countSql, countArgs...).Scan(&total)
+func (built *Built) SqlOfCount() (string, []interface{}) {
+	if built.Custom != nil {
+		result, err := built.Custom.Generate(built)
+		panicIfDialectError(err)
+		if err == nil {
+			if sqlResult, ok := result.(*SQLResult); ok && sqlResult.CountSQL != "" {
+				if sqlResult.CountArgs != nil {
+					return built.commented(sqlResult.CountSQL), sqlResult.CountArgs
+				}
+				return built.commented(sqlResult.CountSQL), built.countArgs()
+			}
+		}
+	}
+
+	panicIfDialectError(built.sqlError())
+	countSql := built.SqlCount()
+	if countSql == "" {
+		return "", nil
+	}
+	return built.commented(countSql), built.countArgs()
+}
+
+// countArgs args of SqlCount(), in the same order as its placeholders
+func (built *Built) countArgs() []interface{} {
+	vs := []interface{}{}
+	sb := strings.Builder{}
+	built.appendWithClauses(&sb, &vs)
+	if built.isCountWrapped() {
+		core := built.countCore()
+		_, selectArgs := core.resultKeysOf()
+		vs = append(vs, selectArgs...)
+		if core.rankAlias != "" {
+			_, rankArgs := core.rankSql()
+			vs = append(vs, rankArgs...)
+		}
+	}
+	built.toFromSql(&vs, &sb)
+	built.toCondSql(built.Conds, &sb, &vs, nil)
+	built.toAggSql(&vs, &sb)
+	built.toHavingSql(&vs, &sb)
+	if built.isCountWrapped() {
+		built.appendUnionClauses(&sb, &vs)
+	}
+	return vs
+}
+
 func (built *Built) SqlOfPage() (string, string, []interface{}, map[string]string) {
 	// ⭐ If Custom is set, try to get from Custom
 	if built.Custom != nil {
 		result, err := built.Custom.Generate(built)
+		panicIfDialectError(err)
 		if err == nil {
 			if sqlResult, ok := result.(*SQLResult); ok {
 				// ⭐ Prefer CountSQL provided by Custom
@@ -443,24 +564,26 @@ func (built *Built) SqlOfPage() (string, string, []interface{}, map[string]strin
 				if meta == nil {
 					meta = make(map[string]string)
 				}
-				return countSQL, sqlResult.SQL, sqlResult.Args, meta
+				return built.commented(countSQL), built.commented(sqlResult.SQL), sqlResult.Args, meta
 			}
 		}
 	}
 
 	// ⭐ Default implementation
+	panicIfDialectError(built.sqlError())
 	vs := []interface{}{}
 	km := make(map[string]string)
 	dataSql, kmp := built.SqlData(&vs, km)
 	countSQL := built.SqlCount()
 
-	return countSQL, dataSql, vs, kmp
+	return built.commented(countSQL), built.commented(dataSql), vs, kmp
 }
 
 func (built *Built) SqlOfSelect() (string, []interface{}, map[string]string) {
 	// ⭐ If Custom is set, try to get from Custom
 	if built.Custom != nil {
 		result, err := built.Custom.Generate(built)
+		panicIfDialectError(err)
 		if err == nil {
 			// ⭐ Type assertion: expect *SQLResult
 			if sqlResult, ok := result.(*SQLResult); ok {
@@ -468,26 +591,28 @@ func (built *Built) SqlOfSelect() (string, []interface{}, map[string]string) {
 				if meta == nil {
 					meta = make(map[string]string)
 				}
-				return sqlResult.SQL, sqlResult.Args, meta
+				return built.commented(sqlResult.SQL), sqlResult.Args, meta
 			}
 		}
 		// If Custom didn't return SQLResult, continue with default implementation
 	}
 
 	// ⭐ Default implementation (original logic)
+	panicIfDialectError(built.sqlError())
 	vs := []interface{}{}
 	km := make(map[string]string)
 	dataSql, kmp := built.SqlData(&vs, km)
-	return dataSql, vs, kmp
+	return built.commented(dataSql), vs, kmp
 }
 
 func (built *Built) SqlOfInsert() (string, []interface{}) {
 	// ⭐ If Custom is set, try to get from Custom
 	if built.Custom != nil {
 		result, err := built.Custom.Generate(built)
+		panicIfDialectError(err)
 		if err == nil {
 			if sqlResult, ok := result.(*SQLResult); ok {
-				return sqlResult.SQL, sqlResult.Args
+				return built.commented(sqlResult.SQL), sqlResult.Args
 			}
 		}
 	}
@@ -495,42 +620,110 @@ func (built *Built) SqlOfInsert() (string, []interface{}) {
 	// ⭐ Default implementation
 	vs := []interface{}{}
 	sql := built.SqlInsert(&vs)
-	return sql, vs
+	return built.commented(sql), vs
 }
 
+// SqlOfInsertBatch generates batch INSERT SQL of InsertRows(), chunked by MaxParams
+//
+// Notes:
+//   - Each statement has at most MaxParams / len(columns) rows (at least 1),
+//     and at most SQLServerInsertMaxRows rows of SQLServerCustom
+//   - The default MaxParams depends on Custom, see insertBatchLimits()
+//   - Every chunk goes through Custom like SqlOfInsert()
+//   - Without InsertRows(), returns the single statement of SqlOfInsert()
+//
+// Example:
+//
+//	for _, r := range built.SqlOfInsertBatch() {
+//	    db.Exec(r.SQL, r.Args...)
+//	}
+func (built *Built) SqlOfInsertBatch() []*SQLResult {
+	if len(built.InsertRows) == 0 {
+		sql, args := built.SqlOfInsert()
+		if sql == "" {
+			return nil
+		}
+		return []*SQLResult{{SQL: sql, Args: args}}
+	}
+
+	maxParams, maxRows := built.insertBatchLimits()
+	if built.insertMaxParams > 0 {
+		maxParams = built.insertMaxParams
+	}
+	size := maxParams / len(*built.Inserts)
+	if size < 1 {
+		size = 1
+	}
+	if maxRows > 0 && size > maxRows {
+		size = maxRows
+	}
+
+	results := []*SQLResult{}
+	for i := 0; i < len(built.InsertRows); i += size {
+		chunk := *built
+		chunk.InsertRows = built.InsertRows[i:min(i+size, len(built.InsertRows))]
+		sql, args := chunk.SqlOfInsert()
+		results = append(results, &SQLResult{SQL: sql, Args: args})
+	}
+	return results
+}
+
+// insertBatchLimits default max parameters and max rows (0: unlimited) of one statement of SqlOfInsertBatch()
+func (built *Built) insertBatchLimits() (maxParams int, maxRows int) {
+	switch built.Custom.(type) {
+	case *SQLServerCustom:
+		return SQLServerInsertMaxParams, SQLServerInsertMaxRows
+	case *SQLiteCustom:
+		return SQLiteInsertMaxParams, 0
+	}
+	return DefaultInsertMaxParams, 0
+}
+
+// SqlOfUpdate panics with ErrFullTable without WHERE (see AllowFullTable()), SqlOfUpdateE() returns it
 func (built *Built) SqlOfUpdate() (string, []interface{}) {
+	panicIfFullTable(built)
+
 	// ⭐ If Custom is set, try to get from Custom
 	if built.Custom != nil {
 		result, err := built.Custom.Generate(built)
+		panicIfDialectError(err)
 		if err == nil {
 			if sqlResult, ok := result.(*SQLResult); ok {
-				return sqlResult.SQL, sqlResult.Args
+				return built.commented(sqlResult.SQL), sqlResult.Args
 			}
 		}
 	}
 
 	// ⭐ Default implementation
+	panicIfDialectError(built.sqlError())
 	vs := []interface{}{}
 	km := make(map[string]string)
 	dataSql, _ := built.SqlData(&vs, km)
-	return dataSql, vs
+	return built.commented(dataSql), vs
 }
 
+// SqlOfDelete panics with ErrFullTable without WHERE (see AllowFullTable()), SqlOfDeleteE() returns it
 func (built *Built) SqlOfDelete() (string, []interface{}) {
+	// ⭐ Automatically set Delete flag (same as JsonOfDelete)
+	built.Delete = true
+	panicIfFullTable(built)
+
 	// ⭐ If Custom is set, try to get from Custom
 	if built.Custom != nil {
 		result, err := built.Custom.Generate(built)
+		panicIfDialectError(err)
 		if err == nil {
 			if sqlResult, ok := result.(*SQLResult); ok {
-				return sqlResult.SQL, sqlResult.Args
+				return built.commented(sqlResult.SQL), sqlResult.Args
 			}
 		}
 	}
 
 	// ⭐ Default implementation
+	panicIfDialectError(built.sqlError())
 	vs := []interface{}{}
 	sql := built.sqlDelete(&vs)
-	return sql, vs
+	return built.commented(sql), vs
 }
 
 func (built *Built) SqlOfCond() (string, string, []interface{}) {
@@ -566,7 +759,10 @@ func (built *Built) countSqlFrom(sbCount *strings.Builder) {
 }
 
 func (built *Built) countSqlWhere(sbCount *strings.Builder) {
-	built.sqlWhere(sbCount)
+	if len(built.Conds) == 0 {
+		return
+	}
+	sbCount.WriteString(WHERE)
 }
 
 func (built *Built) sqlFrom(bp *strings.Builder) {
@@ -576,7 +772,7 @@ func (built *Built) sqlFrom(bp *strings.Builder) {
 }
 
 func (built *Built) sqlWhere(bp *strings.Builder) {
-	if len(built.Conds) == 0 {
+	if len(built.Conds) == 0 && built.filterLast() == nil {
 		return
 	}
 	bp.WriteString(WHERE)
@@ -590,6 +786,7 @@ func (built *Built) sqlDelete(vs *[]interface{}) string {
 	sb := strings.Builder{}
 	sb.Grow(128) // Pre-allocate 128 bytes to reduce memory reallocation
 	built.toDelete(&sb)
+	built.toDeleteTargetSql(&sb)
 	built.sqlFrom(&sb)
 	built.toFromSql(vs, &sb)
 	built.toUpdateSql(&sb, vs)
@@ -598,7 +795,7 @@ func (built *Built) sqlDelete(vs *[]interface{}) string {
 	built.toAggSql(vs, &sb)
 	built.toGroupBySql(&sb)
 	built.toHavingSql(vs, &sb)
-	built.toSortSql(&sb)
+	built.toSortSql(&sb, vs)
 	built.toPageSql(&sb)
 	built.toLastSql(&sb)
 	deleteSql := sb.String()
@@ -626,23 +823,53 @@ func (built *Built) sqlDelete(vs *[]interface{}) string {
 //	sql, meta := built.SqlData(&vs, km)
 //	// SELECT * FROM users WHERE age > ?
 func (built *Built) SqlData(vs *[]interface{}, km map[string]string) (string, map[string]string) {
+	return built.sqlDataOf(vs, km, built.toPageSql)
+}
+
+// sqlDataOf generates data query SQL with the dialect's pagination writer
+// toPageSql can be nil (no pagination, e.g. Oracle ROWNUM wraps the whole statement)
+func (built *Built) sqlDataOf(vs *[]interface{}, km map[string]string, toPageSql func(bp *strings.Builder)) (string, map[string]string) {
 	sb := strings.Builder{}
 	sb.Grow(256) // Pre-allocate 256 bytes, SELECT statements are usually longer
 	built.appendWithClauses(&sb, vs)
-	built.writeSelectCore(&sb, vs, km)
+	built.writeSelectCore(&sb, vs, km, built.filterLast)
 	built.appendUnionClauses(&sb, vs)
-	built.toSortSql(&sb)
-	built.toPageSql(&sb)
+	built.toSortSql(&sb, vs)
+	if toPageSql != nil {
+		toPageSql(&sb)
+	}
+	built.toLockSql(&sb)
 	built.toLastSql(&sb)
 	dataSql := sb.String()
 	return dataSql, km
 }
 
+// pageRange returns the offset and row count of Paged() or Limit()/Offset()
+// rows == 0 means no pagination
+func (built *Built) pageRange() (offset int, rows int) {
+	if built.PageCondition != nil {
+		if built.PageCondition.Rows < 1 {
+			return 0, 0
+		}
+		rows = int(built.PageCondition.Rows)
+		if built.PageCondition.Last < 1 && !built.PageCondition.Keyset {
+			if built.PageCondition.Page < 1 {
+				built.PageCondition.Page = 1
+			}
+			offset = int((built.PageCondition.Page - 1) * built.PageCondition.Rows)
+		}
+		return offset, rows
+	}
+	return built.OffsetValue, built.LimitValue
+}
+
 // SqlCount generates COUNT SQL (for pagination)
 //
 // Notes:
 //   - Used to generate COUNT(*) SQL, usually used with SqlData for pagination
 //   - Custom implementations can call this method to generate count SQL
+//   - ⭐ With GROUP BY / HAVING / DISTINCT / UNION, the data query (without ORDER BY / LIMIT) is wrapped,
+//     force either form by Paged(func(pb) { pb.CountBy(xb.CountPlain) })
 //
 // Returns:
 //   - string: COUNT SQL
@@ -651,13 +878,31 @@ func (built *Built) SqlData(vs *[]interface{}, km map[string]string) (string, ma
 //
 //	countSQL := built.SqlCount()
 //	// SELECT COUNT(*) FROM users WHERE age > ?
+//	// SELECT COUNT(*) FROM (SELECT status FROM users WHERE age > ? GROUP BY status) t
 func (built *Built) SqlCount() string {
 	sbCount := built.countBuilder()
 	if sbCount == nil {
 		return ""
 	}
 	sbCount.Grow(128) // Pre-allocate 128 bytes, COUNT statements are relatively short
+	if built.lockHint || built.selectHint != "" {
+		// ⭐ COUNT doesn't lock rows, and counts all rows, not only TOP n of the page
+		cloned := *built
+		cloned.lockHint = false
+		cloned.selectHint = ""
+		built = &cloned
+	}
 	built.appendWithClauses(sbCount, nil)
+	if built.isCountWrapped() {
+		sbCount.WriteString(COUNT_BASE_SCRIPT)
+		sbCount.WriteString(FROM)
+		sbCount.WriteString(BEGIN_SUB)
+		built.countCore().writeSelectCore(sbCount, nil, make(map[string]string), nil)
+		built.appendUnionClauses(sbCount, nil)
+		sbCount.WriteString(END_SUB)
+		sbCount.WriteString(" t")
+		return built.toSqlCount(sbCount)
+	}
 	built.toResultKeySqlOfCount(sbCount)
 	built.countSqlFrom(sbCount)
 	built.toFromSqlOfCount(sbCount)
@@ -670,6 +915,73 @@ func (built *Built) SqlCount() string {
 	return countSql
 }
 
+// isCountWrapped COUNT of the wrapped data query, if COUNT(*) of the plain form would be per group or miss rows
+func (built *Built) isCountWrapped() bool {
+	if built.PageCondition != nil {
+		switch built.PageCondition.Count {
+		case CountPlain:
+			return false
+		case CountWrapped:
+			return true
+		}
+	}
+	if len(built.GroupBys) > 0 || len(built.Havings) > 0 || len(built.Unions) > 0 {
+		return true
+	}
+	for _, k := range built.ResultKeys {
+		if strings.HasPrefix(strings.ToUpper(strings.TrimSpace(k)), DISTINCT_SCRIPT) {
+			return true
+		}
+	}
+	return false
+}
+
+// countCore the data query wrapped by COUNT, selects only the columns its rows depend on,
+// expressions named c0, c1 ... (a derived table rejects unnamed or duplicate columns, e.g. SQL Server / MySQL):
+//   - DISTINCT / UNION: the select list
+//   - GROUP BY: the GROUP BY keys
+//   - else: 1
+func (built *Built) countCore() *Built {
+	cloned := *built
+	distinct := false
+	for _, k := range built.ResultKeys {
+		if strings.HasPrefix(strings.ToUpper(strings.TrimSpace(k)), DISTINCT_SCRIPT) {
+			distinct = true
+		}
+	}
+	switch {
+	case distinct || len(built.Unions) > 0:
+		cloned.ResultKeys = countKeysOf(built.ResultKeys)
+	case len(built.GroupBys) > 0:
+		cloned.ResultKeys = countKeysOf(built.GroupBys)
+		cloned.selectCases = nil
+		cloned.rankAlias = ""
+	default:
+		cloned.ResultKeys = []string{"1" + AS + "c0"}
+		cloned.selectCases = nil
+		cloned.rankAlias = ""
+	}
+	return &cloned
+}
+
+// countKeysOf names the expressions and the qualified columns c0, c1 ...
+// (plain columns, aliased keys and * / t.* are kept as is)
+func countKeysOf(keys []string) []string {
+	if len(keys) == 0 {
+		return keys
+	}
+	named := make([]string, len(keys))
+	for i, k := range keys {
+		k = strings.TrimSpace(k)
+		plain := identRegex.MatchString(unquoteIdent(k)) && !strings.Contains(k, ".")
+		if !plain && k != "*" && !strings.HasSuffix(k, ".*") && !strings.Contains(strings.ToUpper(k), AS) {
+			k = k + AS + "c" + strconv.Itoa(i)
+		}
+		named[i] = k
+	}
+	return named
+}
+
 func (built *Built) appendWithClauses(sb *strings.Builder, vs *[]interface{}) {
 	if len(built.Withs) == 0 {
 		return
@@ -722,14 +1034,20 @@ func (built *Built) appendUnionClauses(sb *strings.Builder, vs *[]interface{}) {
 	}
 }
 
-func (built *Built) writeSelectCore(sb *strings.Builder, vs *[]interface{}, km map[string]string) {
-	built.toResultKeySql(sb, km)
+// writeSelectCore filterLast: the cursor of Paged(), nil for COUNT
+func (built *Built) writeSelectCore(sb *strings.Builder, vs *[]interface{}, km map[string]string, filterLast func() *Bb) {
+	built.toResultKeySql(sb, vs, km)
 	built.sqlFrom(sb)
 	built.toFromSql(vs, sb)
 	built.toUpdateSql(sb, vs)
-	built.sqlWhere(sb)
-	built.toCondSql(built.Conds, sb, vs, built.filterLast)
+	if filterLast != nil {
+		built.sqlWhere(sb)
+	} else {
+		built.countSqlWhere(sb)
+	}
+	built.toCondSql(built.Conds, sb, vs, filterLast)
 	built.toAggSql(vs, sb)
 	built.toGroupBySql(sb)
 	built.toHavingSql(vs, sb)
+	built.toWindowSql(sb)
 }
@@ -42,6 +42,13 @@ func (built *Built) toUpdateSql(bp *strings.Builder, vs *[]interface{}) {
 					*vs = append(*vs, v)
 				}
 			}
+		} else if c, ok := u.Value.(*CaseBuilder); ok {
+			// Handle Set(k, xb.Case()...), rendered by the dialect
+			expr, args := built.toCaseSql(c)
+			bp.WriteString(u.Key)
+			bp.WriteString(SPACE + EQ + SPACE)
+			bp.WriteString(expr)
+			*vs = append(*vs, args...)
 		} else {
 			// Handle regular Set() method
 			bp.WriteString(u.Key)
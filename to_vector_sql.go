@@ -32,6 +32,7 @@ import (
 //	ORDER BY distance
 //	LIMIT 10
 func (built *Built) SqlOfVectorSearch() (string, []interface{}) {
+	built = built.withDialect(dialectPostgres) // ⭐ pgvector: Match() / JsonPath() of PostgreSQL
 
 	var sb strings.Builder
 	var args []interface{}
@@ -0,0 +1,85 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package xb
+
+import (
+	"strings"
+
+	. "github.com/fndome/xb/internal"
+)
+
+// isJoinedUpdate UPDATE with JOIN of FromX()
+func (built *Built) isJoinedUpdate() bool {
+	return built.Updates != nil && built.OrFromSql == "" && len(built.Fxs) > 1
+}
+
+// joinedUpdateError DialectError of UPDATE with JOIN (Oracle / ClickHouse)
+func (built *Built) joinedUpdateError(dialect string) error {
+	if built.isJoinedUpdate() {
+		return &DialectError{Dialect: dialect, Reason: "UPDATE with JOIN is not supported"}
+	}
+	return nil
+}
+
+// updateTarget the alias (or the name) of the first table of FromX()
+func (built *Built) updateTarget() string {
+	if built.Fxs[0].alia != "" {
+		return built.Fxs[0].alia
+	}
+	return built.Fxs[0].tableName
+}
+
+// sqlUpdateFrom UPDATE t AS o SET x = ? FROM t2 u WHERE <ON of u> AND ... (PostgreSQL / SQLite)
+// the prefix "o." of the columns of SET is removed, SET can't qualify the columns
+// the alias of UPDATE requires AS on SQLite
+func (built *Built) sqlUpdateFrom(vs *[]interface{}, dialect string) (string, error) {
+	first := built.Fxs[0]
+	if first.tableName == "" {
+		return "", &DialectError{Dialect: dialect, Reason: "UPDATE of a subquery is not supported"}
+	}
+
+	sb := strings.Builder{}
+	sb.Grow(128)
+	sb.WriteString(UPDATE)
+	sb.WriteString(first.tableName)
+	if first.alia != "" {
+		sb.WriteString(AS)
+		sb.WriteString(first.alia)
+	}
+
+	updates := make([]Bb, len(*built.Updates))
+	for i, u := range *built.Updates {
+		for _, prefix := range []string{first.alia + ".", first.tableName + "."} {
+			if prefix != "." && strings.HasPrefix(u.Key, prefix) {
+				u.Key = strings.TrimPrefix(u.Key, prefix)
+				break
+			}
+		}
+		updates[i] = u
+	}
+	unqualified := *built
+	unqualified.Updates = &updates
+	unqualified.toUpdateSql(&sb, vs)
+
+	sb.WriteString(FROM)
+	ons, err := built.toUsingSql(vs, &sb, dialect, "UPDATE ... FROM")
+	if err != nil {
+		return "", err
+	}
+	built.toUsingWhereSql(ons, vs, &sb)
+	return sb.String(), nil
+}
@@ -0,0 +1,225 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package xb
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestUpdateJoin_MySQL(t *testing.T) {
+	sql, args := Of("t_order").As("o").
+		Custom(NewMySQLBuilder().Build()).
+		Update(func(ub *UpdateBuilder) {
+			ub.X("o.user_name = u.name").Set("o.flag", 3)
+		}).
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(INNER).Of("t_user").As("u").
+				On("u.id = o.user_id").
+				Cond(func(on *ON) { on.Eq("u.tenant", 5) })
+		}).
+		Eq("u.status", 9).
+		Build().
+		SqlOfUpdate()
+
+	want := "UPDATE t_order o INNER JOIN t_user u ON u.id = o.user_id AND u.tenant = ? SET o.user_name = u.name, o.flag = ?  WHERE u.status = ?"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+	if !reflect.DeepEqual(args, []interface{}{5, 3, 9}) {
+		t.Errorf("args: %v", args)
+	}
+}
+
+func TestUpdateJoin_PostgreSQL(t *testing.T) {
+	sql, args := Of("t_order").As("o").
+		Custom(NewPostgresBuilder().Build()).
+		Update(func(ub *UpdateBuilder) {
+			ub.X("o.user_name = u.name").Set("o.flag", 3)
+		}).
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(INNER).Of("t_user").As("u").
+				On("u.id = o.user_id").
+				Cond(func(on *ON) { on.Eq("u.tenant", 5) })
+		}).
+		Eq("u.status", 9).
+		Build().
+		SqlOfUpdate()
+
+	want := "UPDATE t_order AS o SET user_name = u.name, flag = $1 FROM t_user u WHERE u.id = o.user_id AND u.tenant = $2 AND u.status = $3"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+	if !reflect.DeepEqual(args, []interface{}{3, 5, 9}) {
+		t.Errorf("args: %v", args)
+	}
+}
+
+func TestUpdateJoin_SQLite(t *testing.T) {
+	sql, args := Of("t_order").As("o").
+		Custom(NewSQLiteBuilder().Build()).
+		Update(func(ub *UpdateBuilder) {
+			ub.X("o.user_name = u.name").Set("o.flag", 3)
+		}).
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(INNER).Of("t_user").As("u").On("u.id = o.user_id")
+		}).
+		Eq("u.status", 9).
+		Build().
+		SqlOfUpdate()
+
+	want := "UPDATE t_order AS o SET user_name = u.name, flag = ? FROM t_user u WHERE u.id = o.user_id AND u.status = ?"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+	if !reflect.DeepEqual(args, []interface{}{3, 9}) {
+		t.Errorf("args: %v", args)
+	}
+}
+
+func TestUpdateJoin_SQLiteWithoutAlias(t *testing.T) {
+	sql, _ := Of("t_order").
+		Custom(NewSQLiteBuilder().Build()).
+		Update(func(ub *UpdateBuilder) {
+			ub.X("user_name = t_user.name").Set("t_order.flag", 3)
+		}).
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(INNER).Of("t_user").On("t_user.id = t_order.user_id")
+		}).
+		Eq("t_user.status", 9).
+		Build().
+		SqlOfUpdate()
+
+	want := "UPDATE t_order SET user_name = t_user.name, flag = ? FROM t_user WHERE t_user.id = t_order.user_id AND t_user.status = ?"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+}
+
+func TestUpdateJoin_SQLServer(t *testing.T) {
+	sql, args := Of("t_order").As("o").
+		Custom(NewSQLServerBuilder().Build()).
+		Update(func(ub *UpdateBuilder) {
+			ub.X("o.user_name = u.name").Set("o.flag", 3)
+		}).
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(INNER).Of("t_user").As("u").
+				On("u.id = o.user_id").
+				Cond(func(on *ON) { on.Eq("u.tenant", 5) })
+		}).
+		Eq("u.status", 9).
+		Build().
+		SqlOfUpdate()
+
+	want := "UPDATE [o] SET o.user_name = u.name, [o].[flag] = @p1 FROM [t_order] [o] INNER JOIN [t_user] [u] ON u.id = o.user_id AND [u].[tenant] = @p2 WHERE [u].[status] = @p3"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+	if !reflect.DeepEqual(args, []interface{}{3, 5, 9}) {
+		t.Errorf("args: %v", args)
+	}
+}
+
+func TestUpdateJoin_WithoutConditions(t *testing.T) {
+	built := Of("t_order").As("o").
+		Custom(NewPostgresBuilder().Build()).
+		Update(func(ub *UpdateBuilder) { ub.X("o.user_name = u.name") }).
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(INNER).Of("t_user").As("u").On("u.id = o.user_id")
+		}).
+		Build()
+	if _, _, err := built.SqlOfUpdateE(); !errors.Is(err, ErrFullTable) {
+		t.Errorf("expected ErrFullTable, got %v", err)
+	}
+}
+
+func TestUpdateJoin_PostgresOnWithOr(t *testing.T) {
+	sql, args := Of("t_order").As("o").Custom(NewPostgresBuilder().Build()).
+		Update(func(ub *UpdateBuilder) { ub.Set("o.flag", 3) }).
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(INNER).Of("t_user").As("u").
+				On("u.id = o.user_id").
+				Cond(func(on *ON) { on.OR().Eq("u.id", 1) })
+		}).
+		Eq("u.status", 9).OR().Eq("u.status", 8).
+		Build().SqlOfUpdate()
+	want := "UPDATE t_order AS o SET flag = $1 FROM t_user u " +
+		"WHERE (u.id = o.user_id OR u.id = $2) AND (u.status = $3 OR u.status = $4)"
+	if sql != want {
+		t.Errorf("\ngot:  %s\nwant: %s", sql, want)
+	}
+	if len(args) != 4 || args[1] != 1 || args[3] != 8 {
+		t.Errorf("args: %v", args)
+	}
+}
+
+func TestUpdateJoin_PostgresSubAndMoreJoins(t *testing.T) {
+	sql, args := Of("t_order").As("o").Custom(NewPostgresBuilder().Returning("o.id").Build()).
+		Update(func(ub *UpdateBuilder) {
+			ub.X("total = s.amount").Set("t_order.flag", 1)
+		}).
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(INNER).
+				Sub(func(sb *BuilderX) {
+					sb.Select("order_id", "SUM(amount) AS amount").From("t_item").Gt("amount", 10).GroupBy("order_id")
+				}).As("s").
+				On("s.order_id = o.id").
+				JOIN(LEFT).Of("t_coupon").As("c").On("c.order_id = s.order_id")
+		}).
+		IsNull("c.id").
+		Build().SqlOfUpdate()
+	want := "UPDATE t_order AS o SET total = s.amount, flag = $1 FROM " +
+		"(SELECT order_id, SUM(amount) AS amount FROM t_item WHERE amount > $2 GROUP BY order_id) s " +
+		"LEFT JOIN t_coupon c ON c.order_id = s.order_id WHERE s.order_id = o.id AND c.id IS NULL RETURNING o.id"
+	if sql != want {
+		t.Errorf("\ngot:  %s\nwant: %s", sql, want)
+	}
+	if len(args) != 2 || args[0] != 1 || args[1] != 10 {
+		t.Errorf("args: %v", args)
+	}
+}
+
+func TestUpdateJoin_DialectErrors(t *testing.T) {
+	for _, c := range []Custom{NewOracleBuilder().Build(), NewClickHouseBuilder().Build()} {
+		built := Of("t_order").As("o").Custom(c).
+			Update(func(ub *UpdateBuilder) { ub.Set("o.flag", 3) }).
+			FromX(func(fb *FromBuilder) {
+				fb.JOIN(INNER).Of("t_user").As("u").On("u.id = o.user_id")
+			}).
+			Eq("u.status", 9).
+			Build()
+		_, err := c.Generate(built)
+		var de *DialectError
+		if !errors.As(err, &de) {
+			t.Errorf("%T: expected DialectError, got %v", c, err)
+		}
+	}
+
+	built := Of("t_order").As("o").Custom(NewPostgresBuilder().Build()).
+		Update(func(ub *UpdateBuilder) { ub.Set("flag", 1) }).
+		FromX(func(fb *FromBuilder) {
+			fb.JOIN(LEFT).Of("t_user").As("u").On("u.id = o.user_id")
+		}).
+		IsNull("u.id").
+		Build()
+	_, err := built.Custom.Generate(built)
+	var de *DialectError
+	if !errors.As(err, &de) {
+		t.Errorf("expected DialectError of LEFT JOIN, got %v", err)
+	}
+}
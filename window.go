@@ -0,0 +1,228 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"strconv"
+	"strings"
+
+	. "github.com/fndome/xb/internal"
+)
+
+// Frame bounds of WindowBuilder.Rows() / Range() / Groups()
+const (
+	UnboundedPreceding = "UNBOUNDED PRECEDING"
+	UnboundedFollowing = "UNBOUNDED FOLLOWING"
+	CurrentRow         = "CURRENT ROW"
+)
+
+// Preceding frame bound: n PRECEDING
+func Preceding(n int) string {
+	return strconv.Itoa(n) + " PRECEDING"
+}
+
+// Following frame bound: n FOLLOWING
+func Following(n int) string {
+	return strconv.Itoa(n) + " FOLLOWING"
+}
+
+// WindowClause named window: WINDOW name AS (spec)
+type WindowClause struct {
+	Name string
+	Spec string
+}
+
+// WindowBuilder window spec of Over() / BuilderX.Window()
+type WindowBuilder struct {
+	base        string
+	partitionBy []string
+	orderBy     []Sort
+	frame       string
+}
+
+// Name based on the named window of BuilderX.Window(): OVER (w ORDER BY ...), or OVER w if nothing else
+func (w *WindowBuilder) Name(window string) *WindowBuilder {
+	w.base = window
+	return w
+}
+
+func (w *WindowBuilder) PartitionBy(cols ...string) *WindowBuilder {
+	for _, col := range cols {
+		if col != "" {
+			w.partitionBy = append(w.partitionBy, col)
+		}
+	}
+	return w
+}
+
+func (w *WindowBuilder) OrderBy(orderBy string, direction Direction) *WindowBuilder {
+	if orderBy == "" {
+		return w
+	}
+	sort := Sort{orderBy: orderBy}
+	if direction != nil {
+		sort.direction = direction()
+	}
+	w.orderBy = append(w.orderBy, sort)
+	return w
+}
+
+// Rows frame: ROWS BETWEEN start AND end, or ROWS start if end == ""
+//
+// Example:
+//
+//	w.Rows(xb.UnboundedPreceding, xb.CurrentRow)
+//	w.Rows(xb.Preceding(6), xb.CurrentRow)
+func (w *WindowBuilder) Rows(start string, end string) *WindowBuilder {
+	return w.setFrame("ROWS", start, end)
+}
+
+// Range frame: RANGE BETWEEN start AND end, or RANGE start if end == ""
+func (w *WindowBuilder) Range(start string, end string) *WindowBuilder {
+	return w.setFrame("RANGE", start, end)
+}
+
+// Groups frame (PostgreSQL, SQLite): GROUPS BETWEEN start AND end, or GROUPS start if end == ""
+func (w *WindowBuilder) Groups(start string, end string) *WindowBuilder {
+	return w.setFrame("GROUPS", start, end)
+}
+
+func (w *WindowBuilder) setFrame(unit string, start string, end string) *WindowBuilder {
+	if start == "" {
+		panic("window frame start required")
+	}
+	if end == "" {
+		w.frame = unit + " " + start
+	} else {
+		w.frame = unit + " BETWEEN " + start + " AND " + end
+	}
+	return w
+}
+
+func (w *WindowBuilder) spec() string {
+	parts := []string{}
+	if w.base != "" {
+		parts = append(parts, w.base)
+	}
+	if len(w.partitionBy) > 0 {
+		parts = append(parts, "PARTITION BY "+strings.Join(w.partitionBy, ", "))
+	}
+	if len(w.orderBy) > 0 {
+		sorts := make([]string, 0, len(w.orderBy))
+		for _, sort := range w.orderBy {
+			if sort.direction == "" {
+				sorts = append(sorts, sort.orderBy)
+			} else {
+				sorts = append(sorts, sort.orderBy+" "+sort.direction)
+			}
+		}
+		parts = append(parts, "ORDER BY "+strings.Join(sorts, ", "))
+	}
+	if w.frame != "" {
+		parts = append(parts, w.frame)
+	}
+	return strings.Join(parts, " ")
+}
+
+// WindowExpr fn OVER (...) of Over(), used as a key of Select() by As()
+type WindowExpr struct {
+	fn     string
+	window *WindowBuilder
+}
+
+// Over window function expression, As(alias) is required by Select()
+//
+// Example:
+//
+//	xb.Of("t_order").
+//	    Select("id", "user_id",
+//	        xb.Over("ROW_NUMBER()", func(w *xb.WindowBuilder) {
+//	            w.PartitionBy("user_id").OrderBy("created_at", xb.DESC)
+//	        }).As("rn"),
+//	        xb.Over("SUM(amount)", func(w *xb.WindowBuilder) {
+//	            w.PartitionBy("user_id").OrderBy("created_at", xb.ASC).Rows(xb.UnboundedPreceding, xb.CurrentRow)
+//	        }).As("running_total"),
+//	    ).
+//	    Build()
+//	// SELECT id, user_id, ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY created_at DESC) AS rn, ...
+func Over(fn string, f func(w *WindowBuilder)) *WindowExpr {
+	if fn == "" {
+		panic("window function required, e.g. ROW_NUMBER()")
+	}
+	w := new(WindowBuilder)
+	if f != nil {
+		f(w)
+	}
+	return &WindowExpr{fn: fn, window: w}
+}
+
+// String fn OVER (...), or fn OVER w of a named window
+func (e *WindowExpr) String() string {
+	if e.window.base != "" && len(e.window.partitionBy) == 0 && len(e.window.orderBy) == 0 && e.window.frame == "" {
+		return e.fn + " OVER " + e.window.base
+	}
+	return e.fn + " OVER (" + e.window.spec() + ")"
+}
+
+// As fn OVER (...) AS alias
+func (e *WindowExpr) As(alias string) string {
+	if alias == "" {
+		panic("window function alias required")
+	}
+	return e.String() + AS + alias
+}
+
+// Window defines a named window: WINDOW name AS (...), used by Over(fn, func(w) { w.Name(name) })
+//
+// Example:
+//
+//	xb.Of("t_order").
+//	    Select("id",
+//	        xb.Over("RANK()", func(w *xb.WindowBuilder) { w.Name("w") }).As("rk"),
+//	        xb.Over("SUM(amount)", func(w *xb.WindowBuilder) { w.Name("w") }).As("total"),
+//	    ).
+//	    Window("w", func(w *xb.WindowBuilder) {
+//	        w.PartitionBy("user_id").OrderBy("amount", xb.DESC)
+//	    }).
+//	    Build()
+//	// SELECT ... FROM t_order WINDOW w AS (PARTITION BY user_id ORDER BY amount DESC)
+func (x *BuilderX) Window(name string, f func(w *WindowBuilder)) *BuilderX {
+	if name == "" || f == nil {
+		return x
+	}
+	w := new(WindowBuilder)
+	f(w)
+	x.windows = append(x.windows, WindowClause{Name: name, Spec: w.spec()})
+	return x
+}
+
+func (built *Built) toWindowSql(bp *strings.Builder) {
+	if len(built.Windows) == 0 {
+		return
+	}
+	bp.WriteString(" WINDOW ")
+	for i, window := range built.Windows {
+		if i > 0 {
+			bp.WriteString(", ")
+		}
+		bp.WriteString(window.Name)
+		bp.WriteString(" AS (")
+		bp.WriteString(window.Spec)
+		bp.WriteString(")")
+	}
+}
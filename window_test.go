@@ -0,0 +1,118 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xb
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOver_SelectWithAlias(t *testing.T) {
+	built := Of("t_order").
+		Select("id",
+			Over("ROW_NUMBER()", func(w *WindowBuilder) {
+				w.PartitionBy("user_id").OrderBy("created_at", DESC)
+			}).As("rn"),
+			Over("SUM(amount)", func(w *WindowBuilder) {
+				w.PartitionBy("user_id").OrderBy("created_at", ASC).Rows(UnboundedPreceding, CurrentRow)
+			}).As("running_total"),
+			Over("AVG(amount)", func(w *WindowBuilder) {
+				w.OrderBy("created_at", nil).Range(Preceding(6), Following(1))
+			}).As("avg7"),
+		).
+		Gt("amount", 10).
+		Build()
+
+	sql, args, km := built.SqlOfSelect()
+	want := "SELECT id, ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY created_at DESC) AS rn, " +
+		"SUM(amount) OVER (PARTITION BY user_id ORDER BY created_at ASC ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW) AS running_total, " +
+		"AVG(amount) OVER (ORDER BY created_at RANGE BETWEEN 6 PRECEDING AND 1 FOLLOWING) AS avg7 " +
+		"FROM t_order WHERE amount > ?"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+	if len(args) != 1 {
+		t.Errorf("args: %v", args)
+	}
+	if km["rn"] != "rn" || km["running_total"] != "running_total" {
+		t.Errorf("aliases should be in km: %v", km)
+	}
+}
+
+func TestWindow_Named(t *testing.T) {
+	built := Of("t_order").
+		Select("id",
+			Over("RANK()", func(w *WindowBuilder) { w.Name("w") }).As("rk"),
+			Over("SUM(amount)", func(w *WindowBuilder) { w.Name("w").Rows(UnboundedPreceding, "") }).As("total"),
+		).
+		Window("w", func(w *WindowBuilder) {
+			w.PartitionBy("user_id").OrderBy("amount", DESC)
+		}).
+		Sort("id", ASC).
+		Build()
+
+	sql, _, _ := built.SqlOfSelect()
+	want := "SELECT id, RANK() OVER w AS rk, SUM(amount) OVER (w ROWS UNBOUNDED PRECEDING) AS total " +
+		"FROM t_order WINDOW w AS (PARTITION BY user_id ORDER BY amount DESC) ORDER BY id ASC"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+}
+
+func TestOver_TopNPerGroupWithCTE(t *testing.T) {
+	built := Of("ranked").
+		With("ranked", func(sb *BuilderX) {
+			sb.From("t_order").
+				Select("id", "user_id",
+					Over("ROW_NUMBER()", func(w *WindowBuilder) {
+						w.PartitionBy("user_id").OrderBy("amount", DESC)
+					}).As("rn"),
+				).
+				Eq("status", "paid")
+		}).
+		Lte("rn", 3).
+		Build()
+
+	sql, args, _ := built.SqlOfSelect()
+	want := "WITH ranked AS (SELECT id, user_id, ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY amount DESC) AS rn " +
+		"FROM t_order WHERE status = ?) SELECT * FROM ranked WHERE rn <= ?"
+	if sql != want {
+		t.Errorf("got:  %s\nwant: %s", sql, want)
+	}
+	if !reflect.DeepEqual(args, []interface{}{"paid", 3}) {
+		t.Errorf("args: %v", args)
+	}
+}
+
+func TestOver_PagedCountUsesStar(t *testing.T) {
+	built := Of("t_order").
+		Select(Over("ROW_NUMBER()", func(w *WindowBuilder) {
+			w.PartitionBy("user_id").OrderBy("created_at", DESC)
+		}).As("rn"), "id").
+		Gt("amount", 10).
+		Paged(func(pb *PageBuilder) {
+			pb.Page(1).Rows(10)
+		}).
+		Build()
+
+	countSql, _, _, _ := built.SqlOfPage()
+	want := "SELECT COUNT(*) FROM t_order WHERE amount > ?"
+	if countSql != want {
+		t.Errorf("got:  %s\nwant: %s", countSql, want)
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xdb
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fndome/xb/internal"
+)
+
+var (
+	scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+	timeType    = reflect.TypeOf(time.Time{})
+)
+
+// fieldsCache reflect.Type → map[column][]int (field index of `db` tag)
+var fieldsCache sync.Map
+
+// fieldsOf column → field index of the `db` tags (cached per type), the first field of a column wins
+func fieldsOf(t reflect.Type) map[string][]int {
+	if cached, ok := fieldsCache.Load(t); ok {
+		return cached.(map[string][]int)
+	}
+	fields := make(map[string][]int)
+	for _, f := range internal.DbFieldsOf(t) {
+		if _, ok := fields[f.Column]; !ok {
+			fields[f.Column] = f.Index
+		}
+	}
+	cached, _ := fieldsCache.LoadOrStore(t, fields)
+	return cached.(map[string][]int)
+}
+
+// scanner scans rows into T, the columns are resolved once per query
+type scanner[T any] struct {
+	scalar  bool
+	indexes [][]int // field index of each column, nil: discarded
+}
+
+func newScanner[T any](rows *sql.Rows, km map[string]string) (*scanner[T], error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	if !isStruct(t) {
+		if len(columns) != 1 {
+			return nil, fmt.Errorf("xdb: scan %d columns into %s, a struct is required", len(columns), t)
+		}
+		return &scanner[T]{scalar: true}, nil
+	}
+
+	fields := fieldsOf(t)
+	sc := &scanner[T]{indexes: make([][]int, len(columns))}
+	used := make(map[string]bool)
+	for i, col := range columns {
+		name := resolveColumn(col, km, fields)
+		if index, ok := fields[name]; ok && !used[name] {
+			used[name] = true
+			sc.indexes[i] = index
+		}
+	}
+	return sc, nil
+}
+
+// resolveColumn column of the result set → `db` tag
+// c0 (km: c0 → o.id) → o.id → id, `name` → name
+func resolveColumn(col string, km map[string]string, fields map[string][]int) string {
+	name := col
+	if key, ok := km[col]; ok && key != "" {
+		name = key
+	}
+	name = strings.Trim(name, "`\"[]")
+	if _, ok := fields[name]; ok {
+		return name
+	}
+	if idx := strings.LastIndex(name, "."); idx >= 0 {
+		name = strings.Trim(name[idx+1:], "`\"[]")
+	}
+	return name
+}
+
+func (sc *scanner[T]) scan(rows *sql.Rows, t *T) error {
+	if sc.scalar {
+		return rows.Scan(t)
+	}
+
+	rv := reflect.ValueOf(t).Elem()
+	dests := make([]interface{}, len(sc.indexes))
+	nullables := make([]reflect.Value, len(sc.indexes))
+	for i, index := range sc.indexes {
+		if index == nil {
+			dests[i] = new(interface{})
+			continue
+		}
+		fv := rv.FieldByIndex(index)
+		if fv.Kind() == reflect.Ptr || fv.Addr().Type().Implements(scannerType) {
+			dests[i] = fv.Addr().Interface()
+			continue
+		}
+		// ⭐ NULL of non-pointer field: scan into **T, keep zero value if NULL
+		ptr := reflect.New(reflect.PointerTo(fv.Type()))
+		dests[i] = ptr.Interface()
+		nullables[i] = ptr
+	}
+
+	if err := rows.Scan(dests...); err != nil {
+		return err
+	}
+
+	for i, ptr := range nullables {
+		if !ptr.IsValid() || ptr.Elem().IsNil() {
+			continue
+		}
+		rv.FieldByIndex(sc.indexes[i]).Set(ptr.Elem().Elem())
+	}
+	return nil
+}
+
+func isStruct(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t != timeType && !reflect.PointerTo(t).Implements(scannerType)
+}
@@ -0,0 +1,234 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package xdb runs xb.Built against database/sql and scans rows into structs by `db` tags
+//
+// xb.DialectError of the Custom (e.g. SQL Server paging without Sort()) is returned as error, not panic
+//
+// Example:
+//
+//	built := xb.Of(&Cat{}).Gte("age", 3).Build()
+//	cats, err := xdb.List[Cat](ctx, db, built)
+package xdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/fndome/xb"
+)
+
+// Conn is satisfied by *sql.DB, *sql.Tx and *sql.Conn
+type Conn interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// PageResult rows of one page and the total rows of the COUNT query
+type PageResult[T any] struct {
+	Page      uint  `json:"page"`
+	Rows      uint  `json:"rows"`
+	TotalRows int64 `json:"totalRows"` // 0 if COUNT is skipped (IsTotalRowsIgnored, Rows <= 1)
+	List      []T   `json:"list"`
+	// NextCursor token of the next page of Paged().Cursor(), "" if it's the last page
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+// ErrNotExecutable the Built is not INSERT / UPDATE / DELETE
+var ErrNotExecutable = errors.New("xdb: built is not INSERT / UPDATE / DELETE, call Delete() for DELETE")
+
+// List runs SqlOfSelect() and scans all rows
+//
+// T is a struct (scanned by `db` tags, or the alias of Select("x AS alias")),
+// or a scalar for single column queries, e.g. List[int64] of Select("id")
+func List[T any](ctx context.Context, conn Conn, built *xb.Built) ([]T, error) {
+	if err := built.CheckDialect(); err != nil {
+		return nil, err
+	}
+	query, args, km := built.SqlOfSelect()
+	return query2List[T](ctx, conn, query, args, km)
+}
+
+// One runs SqlOfSelect() and scans the first row, sql.ErrNoRows if no row
+func One[T any](ctx context.Context, conn Conn, built *xb.Built) (T, error) {
+	var t T
+	if err := built.CheckDialect(); err != nil {
+		return t, err
+	}
+	query, args, km := built.SqlOfSelect()
+	rows, err := conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return t, err
+	}
+	defer rows.Close()
+
+	sc, err := newScanner[T](rows, km)
+	if err != nil {
+		return t, err
+	}
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return t, err
+		}
+		return t, sql.ErrNoRows
+	}
+	if err := sc.scan(rows, &t); err != nil {
+		return t, err
+	}
+	return t, rows.Err()
+}
+
+// Page runs COUNT (SqlOfCount()) and data SQL (SqlOfPage()) of Paged()
+//
+// Notes:
+//   - COUNT is skipped if the first page is not full, TotalRows is the size of the list
+//   - Keyset (Paged().Cursor()): no COUNT, NextCursor is the token of the next page if the page is full
+func Page[T any](ctx context.Context, conn Conn, built *xb.Built) (*PageResult[T], error) {
+	if err := built.CheckDialect(); err != nil {
+		return nil, err
+	}
+	_, dataSql, args, km := built.SqlOfPage()
+	list, err := query2List[T](ctx, conn, dataSql, args, km)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PageResult[T]{List: list}
+	if built.PageCondition == nil {
+		result.TotalRows = int64(len(list))
+		return result, nil
+	}
+	result.Page = built.PageCondition.Page
+	result.Rows = built.PageCondition.Rows
+
+	if built.PageCondition.Keyset {
+		if len(list) > 0 && uint(len(list)) == result.Rows {
+			result.NextCursor = built.NextCursor(list[len(list)-1])
+		}
+		return result, nil
+	}
+
+	if result.Page <= 1 && built.PageCondition.Last == 0 && uint(len(list)) < result.Rows {
+		result.TotalRows = int64(len(list))
+		return result, nil
+	}
+
+	countSql, countArgs := built.SqlOfCount()
+	if countSql == "" {
+		return result, nil
+	}
+	rows, err := conn.QueryContext(ctx, countSql, countArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	if rows.Next() {
+		if err := rows.Scan(&result.TotalRows); err != nil {
+			return nil, err
+		}
+	}
+	return result, rows.Err()
+}
+
+// Exec runs INSERT (SqlOfInsertBatch()), UPDATE (SqlOfUpdateE()) or DELETE (SqlOfDeleteE())
+//
+// Notes:
+//   - Chunks of InsertRows() are executed in order, RowsAffected is the sum, LastInsertId of the last chunk
+//   - DELETE requires Delete(), a Built with only conditions is a SELECT
+//   - UPDATE / DELETE without WHERE returns xb.ErrFullTable, unless AllowFullTable()
+func Exec(ctx context.Context, conn Conn, built *xb.Built) (sql.Result, error) {
+	switch {
+	case built.Inserts != nil:
+		if err := built.CheckDialect(); err != nil {
+			return nil, err
+		}
+		total := &batchResult{}
+		for _, r := range built.SqlOfInsertBatch() {
+			res, err := conn.ExecContext(ctx, r.SQL, r.Args...)
+			if err != nil {
+				return total, err
+			}
+			if err := total.add(res); err != nil {
+				return total, err
+			}
+		}
+		return total, nil
+	case built.Updates != nil:
+		query, args, err := built.SqlOfUpdateE()
+		if err != nil {
+			return nil, err
+		}
+		return conn.ExecContext(ctx, query, args...)
+	case built.Delete:
+		query, args, err := built.SqlOfDeleteE()
+		if err != nil {
+			return nil, err
+		}
+		return conn.ExecContext(ctx, query, args...)
+	default:
+		return nil, ErrNotExecutable
+	}
+}
+
+func query2List[T any](ctx context.Context, conn Conn, query string, args []interface{}, km map[string]string) ([]T, error) {
+	rows, err := conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sc, err := newScanner[T](rows, km)
+	if err != nil {
+		return nil, err
+	}
+	list := []T{}
+	for rows.Next() {
+		var t T
+		if err := sc.scan(rows, &t); err != nil {
+			return nil, err
+		}
+		list = append(list, t)
+	}
+	return list, rows.Err()
+}
+
+// batchResult sql.Result of the chunks of InsertRows()
+type batchResult struct {
+	lastInsertId int64
+	rowsAffected int64
+}
+
+func (r *batchResult) add(res sql.Result) error {
+	if id, err := res.LastInsertId(); err == nil {
+		r.lastInsertId = id
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	r.rowsAffected += n
+	return nil
+}
+
+func (r *batchResult) LastInsertId() (int64, error) {
+	return r.lastInsertId, nil
+}
+
+func (r *batchResult) RowsAffected() (int64, error) {
+	return r.rowsAffected, nil
+}
@@ -0,0 +1,382 @@
+// Copyright 2025 me.fndo.xb
+//
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xdb
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/fndome/xb"
+)
+
+// ============================================================================
+// In-process driver: canned rows per query, records executed statements
+// ============================================================================
+
+type fakeResult struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+type fakeCall struct {
+	query string
+	args  []interface{}
+}
+
+type fakeDB struct {
+	results map[string]fakeResult
+	calls   []fakeCall
+}
+
+func (db *fakeDB) Connect(context.Context) (driver.Conn, error) { return &fakeConn{db: db}, nil }
+func (db *fakeDB) Driver() driver.Driver                        { return nil }
+
+func (db *fakeDB) record(query string, args []driver.NamedValue) {
+	vs := make([]interface{}, len(args))
+	for i, a := range args {
+		vs[i] = a.Value
+	}
+	db.calls = append(db.calls, fakeCall{query: query, args: vs})
+}
+
+type fakeConn struct {
+	db *fakeDB
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c *fakeConn) Close() error                        { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.db.record(query, args)
+	result, ok := c.db.results[query]
+	if !ok {
+		return nil, fmt.Errorf("unexpected query: %s", query)
+	}
+	return &fakeRows{result: result}, nil
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.db.record(query, args)
+	return driver.RowsAffected(strings.Count(query, "(") - 1), nil
+}
+
+type fakeRows struct {
+	result fakeResult
+	i      int
+}
+
+func (r *fakeRows) Columns() []string { return r.result.columns }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.i >= len(r.result.rows) {
+		return io.EOF
+	}
+	copy(dest, r.result.rows[r.i])
+	r.i++
+	return nil
+}
+
+func openFake(results map[string]fakeResult) (*sql.DB, *fakeDB) {
+	fake := &fakeDB{results: results}
+	return sql.OpenDB(fake), fake
+}
+
+// ============================================================================
+// Tests
+// ============================================================================
+
+type cat struct {
+	ID    uint64   `db:"id"`
+	Name  string   `db:"name"`
+	Age   *uint    `db:"age"`
+	Owner string   `db:"owner"`
+	Price *float64 `db:"-"`
+}
+
+func (*cat) TableName() string {
+	return "t_cat"
+}
+
+func TestList_ScanByTagsAndAlias(t *testing.T) {
+	built := xb.Of(&cat{}).As("c").
+		Select("c.id", "c.name", "c.age", "u.name AS owner").
+		Gte("c.age", 3).
+		Build()
+	query, _, _ := built.SqlOfSelect()
+
+	db, fake := openFake(map[string]fakeResult{
+		query: {
+			columns: []string{"c0", "c1", "c2", "owner"},
+			rows: [][]driver.Value{
+				{int64(1), "Tom", int64(3), "Ann"},
+				{int64(2), "Kit", nil, nil},
+			},
+		},
+	})
+
+	cats, err := List[cat](context.Background(), db, built)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(cats) != 2 {
+		t.Fatalf("expected 2 cats, got %d", len(cats))
+	}
+	if cats[0].ID != 1 || cats[0].Name != "Tom" || *cats[0].Age != 3 || cats[0].Owner != "Ann" {
+		t.Errorf("unexpected first cat: %+v", cats[0])
+	}
+	if cats[1].Age != nil || cats[1].Owner != "" {
+		t.Errorf("NULL should be nil / zero value: %+v", cats[1])
+	}
+	if !reflect.DeepEqual(fake.calls[0].args, []interface{}{int64(3)}) {
+		t.Errorf("unexpected args: %v", fake.calls[0].args)
+	}
+}
+
+func TestOne_NoRows(t *testing.T) {
+	built := xb.Of(&cat{}).Eq("id", 9).Build()
+	query, _, _ := built.SqlOfSelect()
+
+	db, _ := openFake(map[string]fakeResult{
+		query: {columns: []string{"id", "name"}},
+	})
+
+	_, err := One[cat](context.Background(), db, built)
+	if !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("expected sql.ErrNoRows, got %v", err)
+	}
+}
+
+func TestOne_Scalar(t *testing.T) {
+	built := xb.Of(&cat{}).Select("MAX(age) AS max_age").Build()
+	query, _, _ := built.SqlOfSelect()
+
+	db, _ := openFake(map[string]fakeResult{
+		query: {columns: []string{"max_age"}, rows: [][]driver.Value{{int64(12)}}},
+	})
+
+	maxAge, err := One[int64](context.Background(), db, built)
+	if err != nil || maxAge != 12 {
+		t.Errorf("got %d, %v", maxAge, err)
+	}
+}
+
+func TestPage_CountWithOwnArgs(t *testing.T) {
+	built := xb.Of(&cat{}).
+		Gte("age", 3).
+		Sort("id", xb.DESC).
+		Paged(func(pb *xb.PageBuilder) {
+			pb.Rows(2).Last(100)
+		}).
+		Build()
+	countSql, _ := built.SqlOfCount()
+	_, dataSql, _, _ := built.SqlOfPage()
+
+	db, fake := openFake(map[string]fakeResult{
+		dataSql:  {columns: []string{"id", "name"}, rows: [][]driver.Value{{int64(99), "Tom"}, {int64(98), "Kit"}}},
+		countSql: {columns: []string{"COUNT(*)"}, rows: [][]driver.Value{{int64(42)}}},
+	})
+
+	page, err := Page[cat](context.Background(), db, built)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if page.TotalRows != 42 || len(page.List) != 2 {
+		t.Errorf("unexpected page: %+v", page)
+	}
+	if !reflect.DeepEqual(fake.calls[1].args, []interface{}{int64(3)}) {
+		t.Errorf("COUNT should not bind the cursor arg: %v", fake.calls[1].args)
+	}
+}
+
+func TestPage_SkipsCountOnPartialFirstPage(t *testing.T) {
+	built := xb.Of(&cat{}).
+		Paged(func(pb *xb.PageBuilder) {
+			pb.Page(1).Rows(10)
+		}).
+		Build()
+	_, dataSql, _, _ := built.SqlOfPage()
+
+	db, fake := openFake(map[string]fakeResult{
+		dataSql: {columns: []string{"id"}, rows: [][]driver.Value{{int64(1)}}},
+	})
+
+	page, err := Page[cat](context.Background(), db, built)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.TotalRows != 1 || len(fake.calls) != 1 {
+		t.Errorf("COUNT should be skipped: %+v, %d calls", page, len(fake.calls))
+	}
+}
+
+func TestPage_KeysetNextCursor(t *testing.T) {
+	build := func(token string) *xb.Built {
+		return xb.Of(&cat{}).
+			Sort("name", xb.ASC).
+			Sort("id", xb.DESC).
+			Paged(func(pb *xb.PageBuilder) {
+				pb.Rows(2).Cursor(token)
+			}).
+			Build()
+	}
+	first := build("")
+	_, dataSql, _, _ := first.SqlOfPage()
+
+	db, fake := openFake(map[string]fakeResult{
+		dataSql: {columns: []string{"id", "name"}, rows: [][]driver.Value{{int64(99), "Kit"}, {int64(98), "Tom"}}},
+	})
+
+	page, err := Page[cat](context.Background(), db, first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fake.calls) != 1 || page.NextCursor == "" {
+		t.Fatalf("keyset should skip COUNT and return the next cursor: %+v, %d calls", page, len(fake.calls))
+	}
+
+	_, nextSql, nextArgs, _ := build(page.NextCursor).SqlOfPage()
+	if !strings.Contains(nextSql, "WHERE (name > ? OR (name = ? AND id < ?))") {
+		t.Errorf("unexpected next page SQL: %s", nextSql)
+	}
+	if !reflect.DeepEqual(nextArgs, []interface{}{"Tom", "Tom", uint64(98)}) {
+		t.Errorf("unexpected next page args: %v", nextArgs)
+	}
+}
+
+func TestExec_InsertRowsAndUpdate(t *testing.T) {
+	db, fake := openFake(nil)
+
+	built := xb.Of(&cat{}).
+		InsertRows(func(rb *xb.RowsBuilder) {
+			rb.MaxParams(2)
+			for _, name := range []string{"Tom", "Kit", "Max"} {
+				n := name
+				rb.Row(func(ib *xb.InsertBuilder) {
+					ib.Set("name", n).Set("age", 2)
+				})
+			}
+		}).
+		Build()
+
+	res, err := Exec(context.Background(), db, built)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fake.calls) != 3 {
+		t.Errorf("expected 3 chunks, got %d", len(fake.calls))
+	}
+	if n, _ := res.RowsAffected(); n != 3 {
+		t.Errorf("expected 3 rows affected, got %d", n)
+	}
+
+	built = xb.Of(&cat{}).
+		Update(func(ub *xb.UpdateBuilder) {
+			ub.Set("name", "Tom")
+		}).
+		Eq("id", 1).
+		Build()
+
+	if _, err := Exec(context.Background(), db, built); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(fake.calls[3].query, "UPDATE t_cat SET name = ?") {
+		t.Errorf("unexpected update: %s", fake.calls[3].query)
+	}
+
+	if _, err := Exec(context.Background(), db, xb.Of(&cat{}).Eq("id", 1).Build()); !errors.Is(err, ErrNotExecutable) {
+		t.Errorf("expected ErrNotExecutable, got %v", err)
+	}
+}
+
+func TestExec_DeleteFullTableGuard(t *testing.T) {
+	db, fake := openFake(nil)
+
+	var id int64 // not set, Eq("id", 0) is skipped
+	built := xb.Of(&cat{}).Delete().Eq("id", id).Build()
+	if _, err := Exec(context.Background(), db, built); !errors.Is(err, xb.ErrFullTable) {
+		t.Errorf("expected ErrFullTable, got %v", err)
+	}
+	built = xb.Of(&cat{}).Update(func(ub *xb.UpdateBuilder) { ub.Set("age", 3) }).Eq("id", id).Build()
+	if _, err := Exec(context.Background(), db, built); !errors.Is(err, xb.ErrFullTable) {
+		t.Errorf("expected ErrFullTable, got %v", err)
+	}
+	if len(fake.calls) != 0 {
+		t.Errorf("nothing should be executed: %v", fake.calls)
+	}
+
+	built = xb.Of(&cat{}).Delete().Eq("id", 1).Build()
+	if _, err := Exec(context.Background(), db, built); err != nil {
+		t.Fatal(err)
+	}
+	built = xb.Of(&cat{}).Delete().AllowFullTable().Build()
+	if _, err := Exec(context.Background(), db, built); err != nil {
+		t.Fatal(err)
+	}
+	if len(fake.calls) != 2 || fake.calls[0].query != "DELETE FROM t_cat WHERE id = ?" || fake.calls[1].query != "DELETE FROM t_cat" {
+		t.Errorf("unexpected calls: %v", fake.calls)
+	}
+}
+
+func TestDialectErrorReturned(t *testing.T) {
+	db, fake := openFake(nil)
+
+	built := xb.Of(&cat{}).
+		Custom(xb.NewSQLServerBuilder().Build()).
+		Gte("age", 1).
+		Paged(func(pb *xb.PageBuilder) { pb.Page(2).Rows(10) }).
+		Build()
+	var de *xb.DialectError
+	if _, err := Page[cat](context.Background(), db, built); !errors.As(err, &de) {
+		t.Errorf("expected DialectError of OFFSET without ORDER BY, got %v", err)
+	}
+
+	built = xb.Of(&cat{}).
+		Custom(xb.NewSQLiteBuilder().Build()).
+		Eq("id", 1).
+		Lock(xb.ForUpdate).
+		Build()
+	if _, err := List[cat](context.Background(), db, built); !errors.As(err, &de) {
+		t.Errorf("expected DialectError of Lock() on SQLite, got %v", err)
+	}
+	if _, err := One[cat](context.Background(), db, built); !errors.As(err, &de) {
+		t.Errorf("expected DialectError of Lock() on SQLite, got %v", err)
+	}
+
+	built = xb.Of("t_cat").As("c").Custom(xb.NewSQLiteBuilder().Build()).Delete().
+		FromX(func(fb *xb.FromBuilder) {
+			fb.JOIN(xb.INNER).Of("t_owner").As("o").On("o.id = c.owner_id")
+		}).
+		Eq("o.id", 1).
+		Build()
+	if _, err := Exec(context.Background(), db, built); !errors.As(err, &de) {
+		t.Errorf("expected DialectError of DELETE with JOIN on SQLite, got %v", err)
+	}
+	if len(fake.calls) != 0 {
+		t.Errorf("nothing should be executed: %v", fake.calls)
+	}
+}